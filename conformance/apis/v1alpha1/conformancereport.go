@@ -37,9 +37,40 @@ type ConformanceReport struct {
 	// test report was made for.
 	GatewayAPIVersion string `json:"gatewayAPIVersion"`
 
+	// NonConformantReasons explains why the results of this test run can't
+	// be used to claim conformance, e.g. because timeouts were set above the
+	// max values for conformant implementations. The core results of the
+	// profiles are at most partial when set.
+	NonConformantReasons []string `json:"nonConformantReasons,omitempty"`
+
+	// SupportedFeatures are all the features the test run considered
+	// supported, including the core features of the selected profiles.
+	SupportedFeatures []string `json:"supportedFeatures,omitempty"`
+
+	// ExemptFeatures are the features the implementation opted out of for
+	// this test run.
+	ExemptFeatures []string `json:"exemptFeatures,omitempty"`
+
+	// SkippedTests are the tests that were explicitly skipped for this test
+	// run.
+	SkippedTests []string `json:"skippedTests,omitempty"`
+
 	// Profiles is a list of the conformance profiles that were enabled for
 	// this test run.
 	Profiles []ConformanceProfile `json:"profiles"`
+
+	// Extensions is a list of the results of the implementation-specific test
+	// extensions that were run along with the conformance tests. They have no
+	// bearing on the conformance of the implementation.
+	Extensions []ExtensionReport `json:"extensions,omitempty"`
+
+	// Metrics are the timings measured for each test that awaited successful
+	// responses, sorted by test name.
+	Metrics []TestMetrics `json:"metrics,omitempty"`
+
+	// RouteProgrammingLatency summarizes how long the implementation took to
+	// program route changes, when it was measured.
+	RouteProgrammingLatency *LatencyMetrics `json:"routeProgrammingLatency,omitempty"`
 }
 
 // Implementation provides metadata information on the downstream
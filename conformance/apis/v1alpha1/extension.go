@@ -0,0 +1,31 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// ExtensionReport shows the testing results of an implementation-specific
+// extension of the conformance tests.
+type ExtensionReport struct {
+	// Name is the name of the extension.
+	Name string `json:"name"`
+
+	// Results summarizes the results of the tests of the extension and lists
+	// the features of the extension which were flagged as supported or not.
+	Results ExtendedStatus `json:"results"`
+}
@@ -0,0 +1,55 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestMetrics are timings measured while running a conformance test. They
+// have no bearing on conformance.
+type TestMetrics struct {
+	// Name is the name of the test.
+	Name string `json:"name"`
+
+	// TimeToFirstSuccess is the longest time the test waited for a first
+	// successful response after applying its resources or changing them.
+	TimeToFirstSuccess metav1.Duration `json:"timeToFirstSuccess"`
+
+	// TimeToConsistency is the longest time the test waited for responses to
+	// be consistently successful after applying its resources or changing
+	// them.
+	TimeToConsistency metav1.Duration `json:"timeToConsistency"`
+}
+
+// LatencyMetrics summarize a set of latency measurements.
+type LatencyMetrics struct {
+	// Samples is the number of measurements.
+	Samples int `json:"samples"`
+
+	// P50 is the median latency.
+	P50 metav1.Duration `json:"p50"`
+
+	// P90 is the 90th percentile latency.
+	P90 metav1.Duration `json:"p90"`
+
+	// Max is the highest latency.
+	Max metav1.Duration `json:"max"`
+}
@@ -18,22 +18,33 @@ limitations under the License.
 package conformance_test
 
 import (
+	"net"
+	"os"
 	"strings"
 	"testing"
 
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/gateway-api/conformance/tests"
+	conformanceconfig "sigs.k8s.io/gateway-api/conformance/utils/config"
 	"sigs.k8s.io/gateway-api/conformance/utils/flags"
 	"sigs.k8s.io/gateway-api/conformance/utils/suite"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 )
 
 func TestConformance(t *testing.T) {
+	if *flags.ListTests {
+		if err := suite.ListTests(os.Stdout, tests.ConformanceTests, suite.ListFormat(*flags.ListTestsFormat)); err != nil {
+			t.Fatalf("Error listing conformance tests: %v", err)
+		}
+		return
+	}
+
 	cfg, err := config.GetConfig()
 	if err != nil {
 		t.Fatalf("Error loading Kubernetes config: %v", err)
@@ -42,36 +53,117 @@ func TestConformance(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error initializing Kubernetes client: %v", err)
 	}
+	clientset, err := clientset.NewForConfig(cfg)
+	if err != nil {
+		t.Fatalf("Error initializing Kubernetes clientset: %v", err)
+	}
+
 	v1alpha2.AddToScheme(client.Scheme())
 	v1beta1.AddToScheme(client.Scheme())
 
-	supportedFeatures := parseSupportedFeatures(*flags.SupportedFeatures)
-	exemptFeatures := parseSupportedFeatures(*flags.ExemptFeatures)
-	for feature := range exemptFeatures {
-		supportedFeatures.Delete(feature)
+	supportedFeatures, err := suite.ParseSupportedFeatures(*flags.SupportedFeatures)
+	if err != nil {
+		t.Fatalf("Error parsing supported features: %v", err)
+	}
+	exemptFeatures, err := suite.ParseSupportedFeatures(*flags.ExemptFeatures)
+	if err != nil {
+		t.Fatalf("Error parsing exempt features: %v", err)
+	}
+	skipTests, err := suite.ParseTestNames(*flags.SkipTests, tests.ConformanceTests)
+	if err != nil {
+		t.Fatalf("Error parsing tests to skip: %v", err)
 	}
 
-	t.Logf("Running conformance tests with %s GatewayClass\n cleanup: %t\n debug: %t\n enable all features: %t \n supported features: [%v]\n exempt features: [%v]",
-		*flags.GatewayClassName, *flags.CleanupBaseResources, *flags.ShowDebug, *flags.EnableAllSupportedFeatures, *flags.SupportedFeatures, *flags.ExemptFeatures)
+	conformanceProfiles := parseConformanceProfiles(*flags.ConformanceProfiles)
+	timeoutConfig := parseTimeoutConfig(t, *flags.TimeoutPreset, *flags.TimeoutConfigFile, *flags.Timeouts)
+	usableNetworkAddresses := parseNetworkAddresses(*flags.UsableNetworkAddresses)
+	unusableNetworkAddresses := parseNetworkAddresses(*flags.UnusableNetworkAddresses)
+
+	t.Logf("Running conformance tests with %s GatewayClass\n cleanup: %t\n debug: %t\n enable all features: %t \n supported features: [%v]\n exempt features: [%v]\n skip tests: [%v]\n conformance profiles: [%v]\n usable network addresses: [%v]\n unusable network addresses: [%v]\n parallelism: %d\n run test: %s\n mesh: %t",
+		*flags.GatewayClassName, *flags.CleanupBaseResources, *flags.ShowDebug, *flags.EnableAllSupportedFeatures, *flags.SupportedFeatures, *flags.ExemptFeatures, *flags.SkipTests, *flags.ConformanceProfiles, *flags.UsableNetworkAddresses, *flags.UnusableNetworkAddresses, *flags.Parallelism, *flags.RunTest, *flags.Mesh)
 
 	cSuite := suite.New(suite.Options{
 		Client:                     client,
+		Clientset:                  clientset,
+		RestConfig:                 cfg,
 		GatewayClassName:           *flags.GatewayClassName,
 		Debug:                      *flags.ShowDebug,
 		CleanupBaseResources:       *flags.CleanupBaseResources,
 		SupportedFeatures:          supportedFeatures,
+		ExemptFeatures:             exemptFeatures,
+		SkipTests:                  skipTests,
 		EnableAllSupportedFeatures: *flags.EnableAllSupportedFeatures,
+		ConformanceProfiles:        conformanceProfiles,
+		TimeoutConfig:              timeoutConfig,
+		Parallelism:                *flags.Parallelism,
+		RunTest:                    *flags.RunTest,
+		UsableNetworkAddresses:     usableNetworkAddresses,
+		UnusableNetworkAddresses:   unusableNetworkAddresses,
+		FailOnDrift:                *flags.FailOnDrift,
+		ArtifactsDir:               *flags.ArtifactsDir,
+		ArtifactsRoundTrips:        *flags.ArtifactsRoundTrips,
+		Mesh:                       *flags.Mesh,
 	})
 	cSuite.Setup(t)
 	cSuite.Run(t, tests.ConformanceTests)
+	if *flags.RouteProgrammingLatency > 0 {
+		cSuite.MeasureRouteProgrammingLatency(t, *flags.RouteProgrammingLatency)
+	}
+
+	if *flags.ReportOutput != "" {
+		writeReport(t, cSuite, *flags.ReportOutput)
+	}
+}
+
+// parseConformanceProfiles parses flag arguments and converts the string to
+// sets.Set[suite.ConformanceProfileName]
+func parseConformanceProfiles(f string) sets.Set[suite.ConformanceProfileName] {
+	res := sets.Set[suite.ConformanceProfileName]{}
+	for _, value := range strings.Split(f, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			res.Insert(suite.ConformanceProfileName(value))
+		}
+	}
+	return res
+}
+
+// parseTimeoutConfig builds the TimeoutConfig of the run from the given
+// preset, then overrides its fields with those of the YAML file, if any, and
+// finally with the name=value pairs of the overrides.
+func parseTimeoutConfig(t *testing.T, preset, file, overrides string) conformanceconfig.TimeoutConfig {
+	timeoutConfig, err := conformanceconfig.TimeoutPreset(conformanceconfig.TimeoutPresetName(preset))
+	if err != nil {
+		t.Fatalf("Error parsing timeout config: %v", err)
+	}
+	if file != "" {
+		if err := conformanceconfig.LoadTimeoutConfigFile(&timeoutConfig, file); err != nil {
+			t.Fatalf("Error parsing timeout config: %v", err)
+		}
+	}
+	values, err := conformanceconfig.ParseTimeoutOverrides(overrides)
+	if err == nil {
+		err = conformanceconfig.ApplyTimeoutOverrides(&timeoutConfig, values)
+	}
+	if err != nil {
+		t.Fatalf("Error parsing timeout config: %v", err)
+	}
+	return timeoutConfig
 }
 
-// parseSupportedFeatures parses flag arguments and converts the string to
-// sets.Set[suite.SupportedFeature]
-func parseSupportedFeatures(f string) sets.Set[suite.SupportedFeature] {
-	res := sets.Set[suite.SupportedFeature]{}
+// parseNetworkAddresses parses flag arguments and converts the string to
+// []v1beta1.GatewayAddress. Values that parse as IP addresses are of type
+// IPAddress, all others are of type Hostname.
+func parseNetworkAddresses(f string) []v1beta1.GatewayAddress {
+	var res []v1beta1.GatewayAddress
 	for _, value := range strings.Split(f, ",") {
-		res.Insert(suite.SupportedFeature(value))
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+		addressType := v1beta1.HostnameAddressType
+		if net.ParseIP(value) != nil {
+			addressType = v1beta1.IPAddressType
+		}
+		res = append(res, v1beta1.GatewayAddress{Type: &addressType, Value: value})
 	}
 	return res
 }
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// echo-basic is the echo server used as a backend by the conformance tests. It
+// responds to HTTP requests with a JSON description of the request, and of the
+// Pod it runs in, and echoes back the messages of WebSocket connections made
+// to the /ws path. When the GRPC_ECHO_SERVER environment variable is set, it
+// serves the GrpcEcho service defined in grpcecho.proto over plaintext HTTP/2
+// instead.
+//
+// The following environment variables are read:
+//   - HTTP_PORT: the port to listen on for HTTP requests, defaults to 3000.
+//   - GRPC_ECHO_SERVER: serve the GrpcEcho service instead of HTTP.
+//   - GRPC_PORT: the port to listen on for gRPC requests, defaults to 3000.
+//   - NAMESPACE, INGRESS_NAME, SERVICE_NAME and POD_NAME: included in the
+//     responses, to tell which backend served a request.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/net/websocket"
+)
+
+// RequestAssertions are the details of the request, and of the Pod of the
+// server, that are echoed back in responses. The JSON field names are those
+// the conformance tests decode responses with.
+type RequestAssertions struct {
+	Path    string              `json:"path"`
+	Host    string              `json:"host"`
+	Method  string              `json:"method"`
+	Proto   string              `json:"proto"`
+	Headers map[string][]string `json:"headers"`
+
+	Context
+}
+
+// Context contains information about the Pod the server runs in.
+type Context struct {
+	Namespace string `json:"namespace"`
+	Ingress   string `json:"ingress"`
+	Service   string `json:"service"`
+	Pod       string `json:"pod"`
+}
+
+// echoSetHeader is the request header listing the headers to set in the
+// response, as comma-separated name:value pairs.
+const echoSetHeader = "X-Echo-Set-Header"
+
+func main() {
+	podContext := Context{
+		Namespace: os.Getenv("NAMESPACE"),
+		Ingress:   os.Getenv("INGRESS_NAME"),
+		Service:   os.Getenv("SERVICE_NAME"),
+		Pod:       os.Getenv("POD_NAME"),
+	}
+
+	if os.Getenv("GRPC_ECHO_SERVER") != "" {
+		addr := net.JoinHostPort("", envOrDefault("GRPC_PORT", "3000"))
+		log.Printf("Starting gRPC echo server on %s", addr)
+		log.Fatal(serveGRPC(addr, podContext))
+	}
+
+	addr := net.JoinHostPort("", envOrDefault("HTTP_PORT", "3000"))
+	log.Printf("Starting HTTP echo server on %s", addr)
+	log.Fatal(http.ListenAndServe(addr, newHandler(podContext)))
+}
+
+// newHandler returns the handler of HTTP requests.
+func newHandler(podContext Context) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	// The Origin of WebSocket handshakes is not checked, as it is not set by
+	// all clients.
+	mux.Handle("/ws", websocket.Server{Handler: websocketEchoHandler})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		echoHandler(w, r, podContext)
+	})
+	return mux
+}
+
+// echoHandler responds with the RequestAssertions of the request, after
+// setting the response headers requested with the X-Echo-Set-Header header.
+func echoHandler(w http.ResponseWriter, r *http.Request, podContext Context) {
+	// The headers are logged so that the requests received by mirror
+	// backends, the responses of which are discarded, can be verified.
+	headers, err := json.Marshal(r.Header)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error encoding headers: %v", err), http.StatusInternalServerError)
+		return
+	}
+	log.Printf("Echoing back request made to %s to client (%s) with headers %s", r.URL.Path, r.RemoteAddr, headers)
+
+	assertions := RequestAssertions{
+		Path:    r.RequestURI,
+		Host:    r.Host,
+		Method:  r.Method,
+		Proto:   r.Proto,
+		Headers: r.Header,
+		Context: podContext,
+	}
+	body, err := json.MarshalIndent(assertions, "", " ")
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error encoding response: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	for _, header := range strings.Split(r.Header.Get(echoSetHeader), ",") {
+		name, value, ok := strings.Cut(header, ":")
+		if !ok {
+			continue
+		}
+		w.Header().Set(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	_, _ = w.Write(body)
+}
+
+// websocketEchoHandler sends each message received on the connection back,
+// until the connection is closed.
+func websocketEchoHandler(ws *websocket.Conn) {
+	log.Printf("Echoing back WebSocket messages of client (%s)", ws.Request().RemoteAddr)
+	for {
+		var message string
+		if err := websocket.Message.Receive(ws, &message); err != nil {
+			return
+		}
+		if err := websocket.Message.Send(ws, message); err != nil {
+			return
+		}
+	}
+}
+
+func envOrDefault(name, defaultValue string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return defaultValue
+}
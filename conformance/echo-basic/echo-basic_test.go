@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+var testContext = Context{Namespace: "gateway-conformance-infra", Pod: "infra-backend-v1-abc"}
+
+func TestEchoHandler(t *testing.T) {
+	server := httptest.NewServer(newHandler(testContext))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	rt := &roundtripper.DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	cReq, cRes, err := rt.CaptureRoundTrip(roundtripper.Request{
+		URL:     url.URL{Scheme: "http", Host: serverURL.Host, Path: "/echo", RawQuery: "q=1"},
+		Host:    "echo.example.com",
+		Headers: map[string][]string{"X-Echo-Set-Header": {"X-Foo: bar,X-Baz:qux"}},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 200, cRes.StatusCode)
+	require.Equal(t, []string{"bar"}, cRes.Headers["X-Foo"])
+	require.Equal(t, []string{"qux"}, cRes.Headers["X-Baz"])
+	require.Equal(t, "/echo?q=1", cReq.Path)
+	require.Equal(t, "echo.example.com", cReq.Host)
+	require.Equal(t, "GET", cReq.Method)
+	require.Equal(t, []string{"X-Foo: bar,X-Baz:qux"}, cReq.Headers["X-Echo-Set-Header"])
+	require.Equal(t, testContext.Namespace, cReq.Namespace)
+	require.Equal(t, testContext.Pod, cReq.Pod)
+}
+
+func TestWebSocketEcho(t *testing.T) {
+	server := httptest.NewServer(newHandler(testContext))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	rt := &roundtripper.DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	messages := []string{"hello", "from the", "gateway api conformance tests"}
+	cRes, err := rt.CaptureUpgradeRoundTrip(roundtripper.UpgradeRequest{
+		URL:      url.URL{Scheme: "ws", Host: serverURL.Host, Path: "/ws"},
+		Host:     "websocket.example.com",
+		Messages: messages,
+	})
+	require.NoError(t, err)
+	require.Equal(t, messages, cRes.Messages)
+}
+
+func TestGRPCEchoServer(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	go func() {
+		_ = serveGRPC(addr, testContext)
+	}()
+
+	rt := &grpc.DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	tests := []struct {
+		name     string
+		request  grpc.Request
+		expected grpc.ExpectedResponse
+	}{{
+		name:    "echo",
+		request: grpc.Request{Address: addr, Authority: "grpc.example.com", Metadata: map[string]string{"version": "one"}},
+		expected: grpc.ExpectedResponse{
+			Backend:   "infra-backend-v1",
+			Namespace: testContext.Namespace,
+			ExpectedRequest: &grpc.ExpectedRequest{
+				Headers: map[string]string{"version": "one"},
+			},
+		},
+	}, {
+		name:     "echo two",
+		request:  grpc.Request{Address: addr, Method: grpc.EchoTwoMethod},
+		expected: grpc.ExpectedResponse{Backend: "infra-backend-v1"},
+	}, {
+		name:     "unimplemented",
+		request:  grpc.Request{Address: addr, Method: grpc.EchoThreeMethod},
+		expected: grpc.ExpectedResponse{Response: grpc.Response{Code: codes.Unimplemented}},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var cRes *grpc.CapturedResponse
+			require.Eventually(t, func() bool {
+				cRes, err = rt.CaptureRoundTrip(tc.request)
+				return err == nil
+			}, 5*time.Second, 10*time.Millisecond)
+			require.NoError(t, grpc.CompareResponse(tc.request, cRes, tc.expected))
+		})
+	}
+
+	cRes, err := rt.CaptureRoundTrip(grpc.Request{Address: addr, Authority: "grpc.example.com"})
+	require.NoError(t, err)
+	require.Equal(t, "grpc.example.com", cRes.Response.GetAssertions().GetAuthority())
+	require.Equal(t, grpc.FullMethod(grpc.EchoMethod), cRes.Response.GetAssertions().GetFullyQualifiedMethod())
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "sigs.k8s.io/gateway-api/conformance/echo-basic/grpcecho"
+)
+
+// grpcEchoServer implements the GrpcEcho service, leaving EchoThree
+// unimplemented.
+type grpcEchoServer struct {
+	pb.UnimplementedGrpcEchoServer
+
+	podContext Context
+}
+
+// serveGRPC serves the GrpcEcho service over plaintext HTTP/2 on the given
+// address.
+func serveGRPC(addr string, podContext Context) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer()
+	pb.RegisterGrpcEchoServer(server, &grpcEchoServer{podContext: podContext})
+	return server.Serve(listener)
+}
+
+func (s *grpcEchoServer) Echo(ctx context.Context, _ *pb.EchoRequest) (*pb.EchoResponse, error) {
+	return s.respond(ctx, "Echo")
+}
+
+func (s *grpcEchoServer) EchoTwo(ctx context.Context, _ *pb.EchoRequest) (*pb.EchoResponse, error) {
+	return s.respond(ctx, "EchoTwo")
+}
+
+// respond returns the assertions of the request made to the given method.
+func (s *grpcEchoServer) respond(ctx context.Context, method string) (*pb.EchoResponse, error) {
+	assertions := &pb.Assertions{
+		FullyQualifiedMethod: "/" + pb.GrpcEcho_ServiceDesc.ServiceName + "/" + method,
+		Context: &pb.Context{
+			Namespace:   s.podContext.Namespace,
+			Ingress:     s.podContext.Ingress,
+			ServiceName: s.podContext.Service,
+			Pod:         s.podContext.Pod,
+		},
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for key, values := range md {
+		for _, value := range values {
+			assertions.Headers = append(assertions.Headers, &pb.Header{Key: key, Value: value})
+		}
+	}
+	if authority := md.Get(":authority"); len(authority) > 0 {
+		assertions.Authority = authority[0]
+	}
+	return &pb.EchoResponse{Assertions: assertions}, nil
+}
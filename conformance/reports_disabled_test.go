@@ -0,0 +1,32 @@
+//go:build !experimental
+// +build !experimental
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance_test
+
+import (
+	"testing"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+// writeReport fails the run, since the conformance report types are only
+// available when building with the experimental tag.
+func writeReport(t *testing.T, _ *suite.ConformanceTestSuite, path string) {
+	t.Fatalf("Unable to write conformance report to %s: conformance reports require the experimental build tag, e.g. GOFLAGS='-tags=experimental'", path)
+}
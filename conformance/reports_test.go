@@ -0,0 +1,70 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+	"sigs.k8s.io/gateway-api/conformance/utils/flags"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+// writeReport generates a ConformanceReport from the results of the suite and
+// writes it as YAML to the provided path.
+func writeReport(t *testing.T, cSuite *suite.ConformanceTestSuite, path string) {
+	implementation := confv1a1.Implementation{
+		Organization: *flags.ImplementationOrganization,
+		Project:      *flags.ImplementationProject,
+		URL:          *flags.ImplementationURL,
+		Version:      *flags.ImplementationVersion,
+		Contact:      parseContacts(*flags.ImplementationContact),
+	}
+
+	report, err := cSuite.Report(implementation)
+	if err != nil {
+		t.Fatalf("Error generating conformance report: %v", err)
+	}
+
+	out, err := yaml.Marshal(report)
+	if err != nil {
+		t.Fatalf("Error marshaling conformance report: %v", err)
+	}
+
+	if err := os.WriteFile(path, out, 0o600); err != nil {
+		t.Fatalf("Error writing conformance report to %s: %v", path, err)
+	}
+	t.Logf("Conformance report written to %s", path)
+}
+
+// parseContacts splits the comma-separated contact flag into a list.
+func parseContacts(f string) []string {
+	var contacts []string
+	for _, value := range strings.Split(f, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			contacts = append(contacts, value)
+		}
+	}
+	return contacts
+}
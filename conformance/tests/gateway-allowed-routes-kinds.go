@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewayAllowedRoutesKinds)
+}
+
+var GatewayAllowedRoutesKinds = suite.ConformanceTest{
+	ShortName:   "GatewayAllowedRoutesKinds",
+	Description: "A Gateway in the gateway-conformance-infra namespace should only attach HTTPRoutes to the listeners whose allowedRoutes kinds include HTTPRoute, and update attachedRoutes when the kinds change",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute, suite.SupportGRPCRoute},
+	Manifests:   []string{"tests/gateway-allowed-routes-kinds.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		gwNN := types.NamespacedName{Name: "gateway-allowed-routes-kinds", Namespace: ns}
+		allowedNN := types.NamespacedName{Name: "allowed-kind", Namespace: ns}
+		disallowedNN := types.NamespacedName{Name: "disallowed-kind", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "http-route"), allowedNN)
+
+		t.Run("Gateway listeners should only support the kinds they allow", func(t *testing.T) {
+			listeners := []v1beta1.ListenerStatus{{
+				Name: v1beta1.SectionName("http-route"),
+				SupportedKinds: []v1beta1.RouteGroupKind{{
+					Group: (*v1beta1.Group)(&v1beta1.GroupVersion.Group),
+					Kind:  v1beta1.Kind("HTTPRoute"),
+				}},
+				Conditions: []metav1.Condition{{
+					Type:   string(v1beta1.ListenerConditionResolvedRefs),
+					Status: metav1.ConditionTrue,
+					Reason: string(v1beta1.ListenerReasonResolvedRefs),
+				}},
+				AttachedRoutes: 1,
+			}, {
+				Name: v1beta1.SectionName("grpc-route"),
+				SupportedKinds: []v1beta1.RouteGroupKind{{
+					Group: (*v1beta1.Group)(&v1beta1.GroupVersion.Group),
+					Kind:  v1beta1.Kind("GRPCRoute"),
+				}},
+				Conditions: []metav1.Condition{{
+					Type:   string(v1beta1.ListenerConditionResolvedRefs),
+					Status: metav1.ConditionTrue,
+					Reason: string(v1beta1.ListenerReasonResolvedRefs),
+				}},
+				AttachedRoutes: 0,
+			}}
+
+			kubernetes.GatewayStatusMustHaveListeners(t, s.Client, s.TimeoutConfig, gwNN, listeners)
+		})
+
+		t.Run("HTTPRoute should not be accepted by a listener that doesn't allow its kind", func(t *testing.T) {
+			kubernetes.HTTPRouteMustHaveCondition(t, s.Client, s.TimeoutConfig, disallowedNN, gwNN, metav1.Condition{
+				Type:   string(v1beta1.RouteConditionAccepted),
+				Status: metav1.ConditionFalse,
+				Reason: string(v1beta1.RouteReasonNotAllowedByListeners),
+			})
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, http.ExpectedResponse{
+				Request:  http.Request{Host: "grpc-route.kinds.example.com", Path: "/"},
+				Response: http.Response{StatusCode: 404},
+			})
+		})
+
+		t.Run("HTTP request should be routed by the listener that allows HTTPRoutes", func(t *testing.T) {
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, http.ExpectedResponse{
+				Request:   http.Request{Host: "http-route.kinds.example.com", Path: "/"},
+				Response:  http.Response{StatusCode: 200},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			})
+		})
+
+		t.Run("HTTPRoute should be attached once the listener allows its kind", func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.GetTimeout)
+			defer cancel()
+
+			original := &v1beta1.Gateway{}
+			err := s.Client.Get(ctx, gwNN, original)
+			require.NoErrorf(t, err, "error getting Gateway: %v", err)
+
+			mutate := original.DeepCopy()
+			for i := range mutate.Spec.Listeners {
+				listener := &mutate.Spec.Listeners[i]
+				if listener.Name == "grpc-route" {
+					listener.AllowedRoutes.Kinds = append(listener.AllowedRoutes.Kinds, v1beta1.RouteGroupKind{Kind: "HTTPRoute"})
+				}
+			}
+			err = s.Client.Patch(ctx, mutate, client.MergeFrom(original))
+			require.NoErrorf(t, err, "error patching the Gateway: %v", err)
+
+			kubernetes.HTTPRouteMustHaveCondition(t, s.Client, s.TimeoutConfig, disallowedNN, gwNN, metav1.Condition{
+				Type:   string(v1beta1.RouteConditionAccepted),
+				Status: metav1.ConditionTrue,
+				Reason: string(v1beta1.RouteReasonAccepted),
+			})
+			kubernetes.GatewayListenerMustHaveAttachedRoutes(t, s.Client, s.TimeoutConfig, gwNN, "grpc-route", 1)
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, http.ExpectedResponse{
+				Request:   http.Request{Host: "grpc-route.kinds.example.com", Path: "/"},
+				Response:  http.Response{StatusCode: 200},
+				Backend:   "infra-backend-v2",
+				Namespace: ns,
+			})
+		})
+	},
+}
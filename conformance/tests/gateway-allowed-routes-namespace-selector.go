@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewayAllowedRoutesNamespaceSelector)
+}
+
+var GatewayAllowedRoutesNamespaceSelector = suite.ConformanceTest{
+	ShortName:   "GatewayAllowedRoutesNamespaceSelector",
+	Description: "A Gateway listener in the gateway-conformance-infra namespace that allows routes from namespaces matching a selector should attach and detach an HTTPRoute as the labels of its namespace change",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute, suite.SupportReferenceGrant},
+	Manifests:   []string{"tests/gateway-allowed-routes-namespace-selector.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		gwNN := types.NamespacedName{Name: "gateway-namespace-selector", Namespace: "gateway-conformance-infra"}
+		routeNN := types.NamespacedName{Name: "namespace-selector", Namespace: "gateway-conformance-selector"}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN))
+
+		attached := http.ExpectedResponse{
+			Request:   http.Request{Path: "/"},
+			Response:  http.Response{StatusCode: 200},
+			Backend:   "infra-backend-v1",
+			Namespace: "gateway-conformance-infra",
+		}
+		detached := http.ExpectedResponse{
+			Request:  http.Request{Path: "/"},
+			Response: http.Response{StatusCode: 404},
+		}
+		notAllowed := metav1.Condition{
+			Type:   string(v1beta1.RouteConditionAccepted),
+			Status: metav1.ConditionFalse,
+			Reason: string(v1beta1.RouteReasonNotAllowedByListeners),
+		}
+		accepted := metav1.Condition{
+			Type:   string(v1beta1.RouteConditionAccepted),
+			Status: metav1.ConditionTrue,
+			Reason: string(v1beta1.RouteReasonAccepted),
+		}
+
+		t.Run("HTTPRoute should not be attached while its namespace doesn't match the selector", func(t *testing.T) {
+			kubernetes.HTTPRouteMustHaveCondition(t, s.Client, s.TimeoutConfig, routeNN, gwNN, notAllowed)
+			kubernetes.GatewayListenerMustHaveAttachedRoutes(t, s.Client, s.TimeoutConfig, gwNN, "http", 0)
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, detached)
+		})
+
+		t.Run("HTTPRoute should be attached once its namespace matches the selector", func(t *testing.T) {
+			setNamespaceLabel(t, s, routeNN.Namespace, "gateway-conformance-selector", "allowed")
+			kubernetes.HTTPRouteMustHaveCondition(t, s.Client, s.TimeoutConfig, routeNN, gwNN, accepted)
+			kubernetes.GatewayListenerMustHaveAttachedRoutes(t, s.Client, s.TimeoutConfig, gwNN, "http", 1)
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, attached)
+		})
+
+		t.Run("HTTPRoute should be detached once its namespace no longer matches the selector", func(t *testing.T) {
+			setNamespaceLabel(t, s, routeNN.Namespace, "gateway-conformance-selector", "denied")
+			kubernetes.HTTPRouteMustHaveCondition(t, s.Client, s.TimeoutConfig, routeNN, gwNN, notAllowed)
+			kubernetes.GatewayListenerMustHaveAttachedRoutes(t, s.Client, s.TimeoutConfig, gwNN, "http", 0)
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, detached)
+		})
+	},
+}
+
+// setNamespaceLabel sets the given label on the namespace.
+func setNamespaceLabel(t *testing.T, s *suite.ConformanceTestSuite, namespace, key, value string) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.GetTimeout)
+	defer cancel()
+
+	ns := &v1.Namespace{}
+	err := s.Client.Get(ctx, types.NamespacedName{Name: namespace}, ns)
+	require.NoErrorf(t, err, "error getting namespace %s: %v", namespace, err)
+
+	original := ns.DeepCopy()
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	ns.Labels[key] = value
+	err = s.Client.Patch(ctx, ns, client.MergeFrom(original))
+	require.NoErrorf(t, err, "error labeling namespace %s: %v", namespace, err)
+}
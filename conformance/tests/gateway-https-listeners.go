@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tls"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewayHTTPSListeners)
+}
+
+var GatewayHTTPSListeners = suite.ConformanceTest{
+	ShortName:   "GatewayHTTPSListeners",
+	Description: "A Gateway with multiple HTTPS listeners on the same port terminates TLS with the certificate of the listener matching the requested hostname",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute},
+	Manifests:   []string{"tests/gateway-https-listeners.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		gwNN := types.NamespacedName{Name: "gateway-https-listeners", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "https-first"), types.NamespacedName{Name: "https-first", Namespace: ns})
+		kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "https-second"), types.NamespacedName{Name: "https-second", Namespace: ns})
+
+		runHTTPSListenerTestCases(t, s, gwAddr, []httpsListenerTestCase{{
+			host:        "first.example.com",
+			certificate: "tls-first-certificate",
+			dnsName:     "first.example.com",
+			backend:     "infra-backend-v1",
+		}, {
+			host:        "second.example.org",
+			certificate: "tls-second-certificate",
+			dnsName:     "second.example.org",
+			backend:     "infra-backend-v2",
+		}})
+	},
+}
+
+// httpsListenerTestCase describes an HTTPS request made with the given host as
+// both SNI and Host header, that is expected to be terminated with the given
+// certificate and routed to the given backend.
+type httpsListenerTestCase struct {
+	host string
+	// certificate is the name of the Secret holding the certificate the
+	// Gateway is expected to serve. It is trusted by the client.
+	certificate string
+	// dnsName is the only DNS Subject Alternative Name of the certificate.
+	dnsName string
+	backend string
+}
+
+func runHTTPSListenerTestCases(t *testing.T, s *suite.ConformanceTestSuite, gwAddr string, testCases []httpsListenerTestCase) {
+	t.Helper()
+
+	ns := "gateway-conformance-infra"
+	for i := range testCases {
+		// Declare tc here to avoid loop variable
+		// reuse issues across parallel tests.
+		tc := testCases[i]
+		t.Run("HTTPS request to "+tc.host+" should be served the "+tc.certificate+" certificate", func(t *testing.T) {
+			t.Parallel()
+
+			certPem, keyPem, err := GetTLSSecret(s.Client, types.NamespacedName{Name: tc.certificate, Namespace: ns})
+			require.NoErrorf(t, err, "unexpected error finding TLS secret %s", tc.certificate)
+
+			tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, certPem, keyPem, tc.host, http.ExpectedResponse{
+				Request:   http.Request{Host: tc.host, Path: "/"},
+				Response:  http.Response{CertificateDNSNames: []string{tc.dnsName}},
+				Backend:   tc.backend,
+				Namespace: ns,
+			})
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewayHTTPSWildcardSNI)
+}
+
+var GatewayHTTPSWildcardSNI = suite.ConformanceTest{
+	ShortName:   "GatewayHTTPSWildcardSNI",
+	Description: "A Gateway with an exact and a wildcard HTTPS listener on the same port selects the most specific listener matching the SNI",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute},
+	Manifests:   []string{"tests/gateway-https-wildcard-sni.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		gwNN := types.NamespacedName{Name: "gateway-https-wildcard-sni", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "https-exact"), types.NamespacedName{Name: "https-exact", Namespace: ns})
+		kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "https-wildcard"), types.NamespacedName{Name: "https-wildcard", Namespace: ns})
+
+		runHTTPSListenerTestCases(t, s, gwAddr, []httpsListenerTestCase{{
+			// The exact listener takes precedence over the wildcard one.
+			host:        "exact.wildcard.example.com",
+			certificate: "tls-exact-certificate",
+			dnsName:     "exact.wildcard.example.com",
+			backend:     "infra-backend-v1",
+		}, {
+			host:        "other.wildcard.example.com",
+			certificate: "tls-wildcard-certificate",
+			dnsName:     "*.wildcard.example.com",
+			backend:     "infra-backend-v2",
+		}})
+	},
+}
@@ -34,7 +34,9 @@ func init() {
 var GatewayInvalidTLSConfiguration = suite.ConformanceTest{
 	ShortName:   "GatewayInvalidTLSConfiguration",
 	Description: "A Gateway should fail to become ready if the Gateway has an invalid TLS configuration",
+	Features:    []suite.SupportedFeature{suite.SupportGateway},
 	Manifests:   []string{"tests/gateway-invalid-tls-certificateref.yaml"},
+	Parallel:    true,
 	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
 		listeners := []v1beta1.ListenerStatus{{
 			Name: v1beta1.SectionName("https"),
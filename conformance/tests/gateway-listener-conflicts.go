@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewayListenerConflicts)
+}
+
+var GatewayListenerConflicts = suite.ConformanceTest{
+	ShortName:   "GatewayListenerConflicts",
+	Description: "Listeners of a Gateway in the gateway-conformance-infra namespace should have a true Conflicted condition when they share a port with another listener and either have the same hostname or a conflicting protocol",
+	Features:    []suite.SupportedFeature{suite.SupportGateway},
+	Manifests:   []string{"tests/gateway-listener-conflicts.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		testCases := []struct {
+			gateway   string
+			listeners []string
+			reason    v1beta1.ListenerConditionReason
+		}{{
+			gateway:   "gateway-listener-hostname-conflict",
+			listeners: []string{"http-first", "http-second"},
+			reason:    v1beta1.ListenerReasonHostnameConflict,
+		}, {
+			gateway:   "gateway-listener-protocol-conflict",
+			listeners: []string{"http", "https"},
+			reason:    v1beta1.ListenerReasonProtocolConflict,
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			gwNN := types.NamespacedName{Name: tc.gateway, Namespace: "gateway-conformance-infra"}
+			for _, listener := range tc.listeners {
+				listener := listener
+				t.Run("Gateway "+tc.gateway+" listener "+listener+" should have a true Conflicted condition with reason "+string(tc.reason), func(t *testing.T) {
+					t.Parallel()
+
+					kubernetes.GatewayListenerMustHaveCondition(t, s.Client, s.TimeoutConfig, gwNN, listener, metav1.Condition{
+						Type:   string(v1beta1.ListenerConditionConflicted),
+						Status: metav1.ConditionTrue,
+						Reason: string(tc.reason),
+					})
+				})
+			}
+		}
+	},
+}
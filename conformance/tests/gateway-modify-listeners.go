@@ -38,6 +38,7 @@ func init() {
 var GatewayModifyListeners = suite.ConformanceTest{
 	ShortName:   "GatewayModifyListeners",
 	Description: "A Gateway in the gateway-conformance-infra namespace should handle adding and removing listeners.",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute},
 	Manifests:   []string{"tests/gateway-modify-listeners.yaml"},
 	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
 
@@ -34,7 +34,7 @@ func init() {
 var GatewaySecretInvalidReferenceGrant = suite.ConformanceTest{
 	ShortName:   "GatewaySecretInvalidReferenceGrant",
 	Description: "A Gateway in the gateway-conformance-infra namespace should fail to become ready if the Gateway has a certificateRef for a Secret in the gateway-conformance-web-backend namespace and a ReferenceGrant exists but does not grant permission to that specific Secret",
-	Features:    []suite.SupportedFeature{suite.SupportReferenceGrant},
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportReferenceGrant},
 	Manifests:   []string{"tests/gateway-secret-invalid-reference-grant.yaml"},
 	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
 		gwNN := types.NamespacedName{Name: "gateway-secret-invalid-reference-grant", Namespace: "gateway-conformance-infra"}
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tls"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewaySecretReferenceGrantRevocation)
+}
+
+var GatewaySecretReferenceGrantRevocation = suite.ConformanceTest{
+	ShortName:   "GatewaySecretReferenceGrantRevocation",
+	Description: "A Gateway in the gateway-conformance-infra namespace should stop serving a certificateRef for a Secret in the gateway-conformance-web-backend namespace when the ReferenceGrant permitting it is deleted or narrowed, and serve it again once it is granted again",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute, suite.SupportReferenceGrant},
+	Manifests:   []string{"tests/gateway-secret-reference-grant-revocation.yaml"},
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		gwNN := types.NamespacedName{Name: "gateway-secret-reference-grant-revocation", Namespace: "gateway-conformance-infra"}
+		routeNN := types.NamespacedName{Name: "gateway-secret-reference-grant-revocation", Namespace: "gateway-conformance-infra"}
+		grantNN := types.NamespacedName{Name: "reference-grant-secret-revocation", Namespace: "gateway-conformance-web-backend"}
+		certNN := types.NamespacedName{Name: "tls-reference-grant-certificate", Namespace: "gateway-conformance-web-backend"}
+		host := "reference-grant.example.com"
+
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "https"), routeNN)
+
+		certPem, keyPem, err := GetTLSSecret(s.Client, certNN)
+		require.NoErrorf(t, err, "unexpected error finding TLS secret %s", certNN)
+
+		served := http.ExpectedResponse{
+			Request:   http.Request{Host: host, Path: "/"},
+			Response:  http.Response{CertificateDNSNames: []string{host}},
+			Backend:   "infra-backend-v1",
+			Namespace: "gateway-conformance-infra",
+		}
+
+		expectGranted := func(t *testing.T) {
+			kubernetes.GatewayListenerMustHaveCondition(t, s.Client, s.TimeoutConfig, gwNN, "https", metav1.Condition{
+				Type:   string(v1beta1.ListenerConditionResolvedRefs),
+				Status: metav1.ConditionTrue,
+				Reason: string(v1beta1.ListenerReasonResolvedRefs),
+			})
+			tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, certPem, keyPem, host, served)
+		}
+		expectRevoked := func(t *testing.T) {
+			kubernetes.GatewayListenerMustHaveCondition(t, s.Client, s.TimeoutConfig, gwNN, "https", metav1.Condition{
+				Type:   string(v1beta1.ListenerConditionResolvedRefs),
+				Status: metav1.ConditionFalse,
+				Reason: string(v1beta1.ListenerReasonRefNotPermitted),
+			})
+			tls.MakeTLSRequestAndExpectEventuallyConsistentFailure(t, s.RoundTripper, s.TimeoutConfig, gwAddr, certPem, keyPem, host, served)
+		}
+
+		t.Run("HTTPS request should be served the certificate while the ReferenceGrant exists", expectGranted)
+
+		original := referenceGrantMustExist(t, s, grantNN)
+
+		t.Run("HTTPS request should no longer be served the certificate once the ReferenceGrant is deleted", func(t *testing.T) {
+			deleteReferenceGrant(t, s, grantNN)
+			expectRevoked(t)
+		})
+
+		t.Run("HTTPS request should be served the certificate again once the ReferenceGrant is recreated", func(t *testing.T) {
+			createReferenceGrant(t, s, original)
+			expectGranted(t)
+		})
+
+		t.Run("HTTPS request should no longer be served the certificate once the ReferenceGrant no longer includes the Secret", func(t *testing.T) {
+			narrowed := original.Spec.DeepCopy()
+			name := v1beta1.ObjectName("certificate")
+			narrowed.To[0].Name = &name
+			updateReferenceGrantSpec(t, s, grantNN, *narrowed)
+			expectRevoked(t)
+		})
+
+		t.Run("HTTPS request should be served the certificate again once the ReferenceGrant includes the Secret again", func(t *testing.T) {
+			updateReferenceGrantSpec(t, s, grantNN, original.Spec)
+			expectGranted(t)
+		})
+	},
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GatewayStaticAddresses)
+}
+
+var GatewayStaticAddresses = suite.ConformanceTest{
+	ShortName:   "GatewayStaticAddresses",
+	Description: "A Gateway in the gateway-conformance-infra namespace should report unsupported and unassignable requested addresses in its status, and be assigned usable requested addresses.",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportGatewayStaticAddresses,
+	},
+	Manifests: []string{"tests/gateway-static-addresses.yaml"},
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		gwNN := types.NamespacedName{Name: "gateway-static-addresses", Namespace: "gateway-conformance-infra"}
+
+		t.Run("listener should not be accepted with an unsupported address type", func(t *testing.T) {
+			kubernetes.GatewayListenerMustHaveCondition(t, s.Client, s.TimeoutConfig, gwNN, "http", metav1.Condition{
+				Type:   string(v1beta1.ListenerConditionAccepted),
+				Status: metav1.ConditionFalse,
+				Reason: string(v1beta1.ListenerReasonUnsupportedAddress),
+			})
+		})
+
+		t.Run("Gateway should not be ready with unassignable addresses", func(t *testing.T) {
+			if len(s.UnusableNetworkAddresses) == 0 {
+				t.Skip("no unusable network addresses configured")
+			}
+			updateGatewayAddresses(t, s, gwNN, s.UnusableNetworkAddresses)
+			kubernetes.GatewayMustHaveCondition(t, s.Client, s.TimeoutConfig, gwNN, metav1.Condition{
+				Type:   string(v1beta1.GatewayConditionReady),
+				Status: metav1.ConditionFalse,
+				Reason: string(v1beta1.GatewayReasonAddressNotAssigned),
+			})
+		})
+
+		t.Run("Gateway should be assigned usable addresses", func(t *testing.T) {
+			if len(s.UsableNetworkAddresses) == 0 {
+				t.Skip("no usable network addresses configured")
+			}
+			updateGatewayAddresses(t, s, gwNN, s.UsableNetworkAddresses)
+			kubernetes.GatewayMustHaveCondition(t, s.Client, s.TimeoutConfig, gwNN, metav1.Condition{
+				Type:   string(v1beta1.GatewayConditionProgrammed),
+				Status: metav1.ConditionTrue,
+			})
+			kubernetes.GatewayStatusMustHaveValidAddresses(t, s.Client, s.TimeoutConfig, gwNN, s.UsableNetworkAddresses)
+		})
+	},
+}
+
+// updateGatewayAddresses replaces the addresses requested in the spec of the
+// given Gateway.
+func updateGatewayAddresses(t *testing.T, s *suite.ConformanceTestSuite, gwNN types.NamespacedName, addresses []v1beta1.GatewayAddress) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.GetTimeout)
+	defer cancel()
+
+	gw := &v1beta1.Gateway{}
+	err := s.Client.Get(ctx, gwNN, gw)
+	require.NoErrorf(t, err, "error getting Gateway: %v", err)
+
+	gw.Spec.Addresses = addresses
+	err = s.Client.Update(ctx, gw)
+	require.NoErrorf(t, err, "error updating Gateway addresses: %v", err)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GRPCRouteWeightedBackends)
+}
+
+var GRPCRouteWeightedBackends = suite.ConformanceTest{
+	ShortName:   "GRPCRouteWeightedBackends",
+	Description: "A single GRPCRoute splitting requests between backends according to their weights",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportGRPCRoute},
+	Manifests:   []string{"tests/grpcroute-weighted-backends.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "weighted-backends", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndGRPCRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		testCases := []struct {
+			split   string
+			weights map[string]int32
+		}{{
+			split:   "even",
+			weights: map[string]int32{"grpc-infra-backend-v1": 1, "grpc-infra-backend-v2": 1},
+		}, {
+			split:   "uneven",
+			weights: map[string]int32{"grpc-infra-backend-v1": 70, "grpc-infra-backend-v2": 20, "grpc-infra-backend-v3": 10},
+		}, {
+			split:   "zero",
+			weights: map[string]int32{"grpc-infra-backend-v1": 1, "grpc-infra-backend-v2": 1, "grpc-infra-backend-v3": 0},
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.split, func(t *testing.T) {
+				t.Parallel()
+				req := grpc.Request{
+					Authority: "weighted-backends.grpc.example.com",
+					Metadata:  map[string]string{"split": tc.split},
+				}
+				grpc.MakeRequestsAndExpectWeightedBackends(t, suite.GRPCRoundTripper, suite.TimeoutConfig, gwAddr, req, http.WeightedBackends{Weights: tc.weights})
+			})
+		}
+	},
+}
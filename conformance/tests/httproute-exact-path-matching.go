@@ -33,6 +33,7 @@ func init() {
 var HTTPExactPathMatching = suite.ConformanceTest{
 	ShortName:   "HTTPExactPathMatching",
 	Description: "A single HTTPRoute with exact path matching for different backends",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute},
 	Manifests:   []string{"tests/httproute-exact-path-matching.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
 		ns := "gateway-conformance-infra"
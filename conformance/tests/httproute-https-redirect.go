@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tls"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteHTTPSRedirect)
+}
+
+var HTTPRouteHTTPSRedirect = suite.ConformanceTest{
+	ShortName:   "HTTPRouteHTTPSRedirect",
+	Description: "An HTTPRoute attached to an HTTP listener redirects to the HTTPS listener of the same Gateway, which terminates TLS and routes the request",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute, suite.SupportHTTPRouteSchemeRedirect},
+	Manifests:   []string{"tests/httproute-https-redirect.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		host := "secure.example.com"
+		gwNN := types.NamespacedName{Name: "gateway-https-redirect", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "http"), types.NamespacedName{Name: "https-redirect", Namespace: ns})
+		kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "https"), types.NamespacedName{Name: "https-backend", Namespace: ns})
+		httpsAddr := kubernetes.GatewayListenerAddress(t, s.Client, s.TimeoutConfig, gwNN, gwAddr, "https")
+
+		certPem, keyPem, err := GetTLSSecret(s.Client, types.NamespacedName{Name: "tls-secure-certificate", Namespace: ns})
+		require.NoError(t, err, "unexpected error finding TLS secret")
+
+		// The port of the redirect is not checked, as the HTTPS listener may
+		// not be on the well-known port when listener ports are remapped.
+		// Instead, the redirected request is sent to the HTTPS listener.
+		t.Run("HTTP request should be redirected to HTTPS", func(t *testing.T) {
+			expected := http.ExpectedResponse{
+				Request:   http.Request{Host: host, Path: "/path", UnfollowRedirect: true},
+				Response:  http.Response{StatusCode: 301},
+				Namespace: ns,
+			}
+			req := http.MakeRequest(t, &expected, gwAddr, "HTTP", "http")
+			http.AwaitConvergenceWithTimeoutConfig(t, s.TimeoutConfig.RequiredConsecutiveSuccesses, s.TimeoutConfig, func(elapsed time.Duration) bool {
+				_, cRes, err := s.RoundTripper.CaptureRoundTrip(req)
+				if err != nil {
+					t.Logf("Request failed, not ready yet: %v (after %v)", err.Error(), elapsed)
+					return false
+				}
+				if cRes.StatusCode != expected.Response.StatusCode || cRes.RedirectRequest == nil {
+					t.Logf("Expected a %d redirect, got status code %d, not ready yet (after %v)", expected.Response.StatusCode, cRes.StatusCode, elapsed)
+					return false
+				}
+				redirect := cRes.RedirectRequest
+				if redirect.Scheme != "https" || redirect.Host != host || redirect.Path != "/path" {
+					t.Logf("Expected a redirect to https://%s/path, got %s://%s%s, not ready yet (after %v)", host, redirect.Scheme, redirect.Host, redirect.Path, elapsed)
+					return false
+				}
+				return true
+			})
+		})
+
+		t.Run("Redirected HTTPS request should be terminated with the listener certificate", func(t *testing.T) {
+			tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, httpsAddr, certPem, keyPem, host, http.ExpectedResponse{
+				Request:   http.Request{Host: host, Path: "/path"},
+				Response:  http.Response{CertificateDNSNames: []string{host}},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			})
+		})
+	},
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRoutePrecedenceMatrix)
+}
+
+var HTTPRoutePrecedenceMatrix = suite.ConformanceTest{
+	ShortName:   "HTTPRoutePrecedenceMatrix",
+	Description: "Requests matched by several HTTPRoutes should be routed according to the HTTPRoute precedence rules, including the creationTimestamp and namespace/name tie-breaks",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute},
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		gwNN := types.NamespacedName{Name: "all-namespaces", Namespace: "gateway-conformance-infra"}
+
+		for i := range precedenceCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := precedenceCases[i]
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				for _, feature := range tc.features {
+					if !s.SupportedFeatures.Has(feature) {
+						t.Skipf("Skipping %s: suite does not support %s", tc.name, feature)
+					}
+				}
+
+				hostname := tc.name + ".precedence.example.com"
+				if tc.tieBreak == precedenceByName {
+					// creationTimestamps have a resolution of one second, so
+					// routes created at the start of a second are likely to
+					// share their creationTimestamp.
+					time.Sleep(time.Until(time.Now().Truncate(time.Second).Add(time.Second)))
+				}
+				routeNNs := make([]types.NamespacedName, 0, len(tc.routes))
+				for j, r := range tc.routes {
+					if tc.tieBreak == precedenceByCreationTimestamp && j > 0 {
+						// creationTimestamps have a resolution of one second.
+						time.Sleep(time.Second + 100*time.Millisecond)
+					}
+					route := r.httpRoute(tc.name, gwNN, hostname)
+					s.Applier.MustApplyObjectsWithCleanup(t, s.Client, s.TimeoutConfig, []client.Object{route}, true)
+					routeNNs = append(routeNNs, client.ObjectKeyFromObject(route))
+				}
+
+				gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNNs...)
+
+				// The routes are read back, so that the precedence is computed
+				// with the creationTimestamps set by the API server.
+				routes := make([]*v1beta1.HTTPRoute, 0, len(routeNNs))
+				for _, routeNN := range routeNNs {
+					route := &v1beta1.HTTPRoute{}
+					ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.GetTimeout)
+					err := s.Client.Get(ctx, routeNN, route)
+					cancel()
+					require.NoErrorf(t, err, "error fetching HTTPRoute %s", routeNN)
+					routes = append(routes, route)
+				}
+
+				winner, rule := precedenceWinner(routes)
+				t.Logf("Expecting %s/%s to take precedence by %s", winner.Namespace, winner.Name, rule)
+				if tc.tieBreak == precedenceByName && rule != precedenceByName {
+					// The API server may have created the routes in different
+					// seconds, in which case the expectation still holds but
+					// the name tie-break is not exercised.
+					t.Logf("The routes of %s have distinct creationTimestamps, the namespace/name tie-break is not exercised", tc.name)
+				} else if tc.tieBreak != "" {
+					require.Equalf(t, tc.tieBreak, rule, "expected the routes of %s to be ordered by %s", tc.name, tc.tieBreak)
+				}
+
+				request := tc.request
+				request.Host = hostname
+				http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, http.ExpectedResponse{
+					Request:   request,
+					Response:  http.Response{StatusCode: 200},
+					Backend:   string(winner.Spec.Rules[0].BackendRefs[0].Name),
+					Namespace: winner.Namespace,
+				})
+			})
+		}
+	},
+}
+
+// precedenceRoute is an HTTPRoute with a single rule with a single match,
+// routing to a backend in the namespace of the route.
+type precedenceRoute struct {
+	namespace string
+	// suffix is appended to the name of the test case to name the route.
+	suffix  string
+	backend string
+	match   v1beta1.HTTPRouteMatch
+}
+
+func (r precedenceRoute) httpRoute(caseName string, gwNN types.NamespacedName, hostname string) *v1beta1.HTTPRoute {
+	gwNamespace := v1beta1.Namespace(gwNN.Namespace)
+	port := v1beta1.PortNumber(8080)
+	return &v1beta1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1beta1.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "precedence-" + caseName + "-" + r.suffix,
+			Namespace: r.namespace,
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			CommonRouteSpec: v1beta1.CommonRouteSpec{
+				ParentRefs: []v1beta1.ParentReference{{
+					Name:      v1beta1.ObjectName(gwNN.Name),
+					Namespace: &gwNamespace,
+				}},
+			},
+			Hostnames: []v1beta1.Hostname{v1beta1.Hostname(hostname)},
+			Rules: []v1beta1.HTTPRouteRule{{
+				Matches: []v1beta1.HTTPRouteMatch{r.match},
+				BackendRefs: []v1beta1.HTTPBackendRef{{
+					BackendRef: v1beta1.BackendRef{
+						BackendObjectReference: v1beta1.BackendObjectReference{
+							Name: v1beta1.ObjectName(r.backend),
+							Port: &port,
+						},
+					},
+				}},
+			}},
+		},
+	}
+}
+
+// precedenceCase creates the given routes, in order, which must all match the
+// request, and expects the request to be routed by the route that takes
+// precedence according to precedenceWinner.
+type precedenceCase struct {
+	name     string
+	features []suite.SupportedFeature
+	routes   []precedenceRoute
+	request  http.Request
+	// tieBreak is the tie-break rule exercised by routes with identical
+	// matches. With precedenceByCreationTimestamp, the routes are created one
+	// second apart, so that they have distinct creationTimestamps. With
+	// precedenceByName, they are created at the start of a second, so that
+	// they are likely to share their creationTimestamp.
+	tieBreak string
+}
+
+// The rules routes are ordered by, which are reported by precedenceWinner.
+const (
+	precedenceByExactPath         = "Exact path match"
+	precedenceByPrefixLength      = "longest path prefix"
+	precedenceByMethod            = "method match"
+	precedenceByHeaders           = "largest number of header matches"
+	precedenceByQueryParams       = "largest number of query param matches"
+	precedenceByCreationTimestamp = "oldest creationTimestamp"
+	precedenceByName              = "namespace/name order"
+)
+
+// The routes of each case are created from lowest to highest precedence by
+// the rule being tested, so that a higher precedence route is never also the
+// oldest one.
+var precedenceCases = []precedenceCase{{
+	name: "exact-over-prefix",
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "prefix", backend: "infra-backend-v1", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/precedence")},
+		{namespace: "gateway-conformance-infra", suffix: "exact", backend: "infra-backend-v2", match: precedencePathMatch(v1beta1.PathMatchExact, "/precedence")},
+	},
+	request: http.Request{Path: "/precedence"},
+}, {
+	name: "longest-prefix",
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "short", backend: "infra-backend-v1", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/precedence")},
+		{namespace: "gateway-conformance-infra", suffix: "longer", backend: "infra-backend-v2", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/precedence/longer")},
+		{namespace: "gateway-conformance-infra", suffix: "longest", backend: "infra-backend-v3", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/precedence/longer/longest")},
+	},
+	request: http.Request{Path: "/precedence/longer/longest/path"},
+}, {
+	name:     "method",
+	features: []suite.SupportedFeature{suite.SupportHTTPRouteMethodMatching},
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "any", backend: "infra-backend-v1", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/")},
+		{namespace: "gateway-conformance-infra", suffix: "get", backend: "infra-backend-v2", match: withMethod(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), v1beta1.HTTPMethodGet)},
+	},
+	request: http.Request{Method: "GET", Path: "/"},
+}, {
+	name:     "path-over-method",
+	features: []suite.SupportedFeature{suite.SupportHTTPRouteMethodMatching},
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "get", backend: "infra-backend-v1", match: withMethod(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), v1beta1.HTTPMethodGet)},
+		{namespace: "gateway-conformance-infra", suffix: "path", backend: "infra-backend-v2", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/precedence")},
+	},
+	request: http.Request{Method: "GET", Path: "/precedence"},
+}, {
+	name: "most-headers",
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "one", backend: "infra-backend-v1", match: withHeaders(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), "color", "red")},
+		{namespace: "gateway-conformance-infra", suffix: "two", backend: "infra-backend-v2", match: withHeaders(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), "color", "red", "shape", "circle")},
+	},
+	request: http.Request{Path: "/", Headers: map[string]string{"color": "red", "shape": "circle"}},
+}, {
+	name:     "method-over-headers",
+	features: []suite.SupportedFeature{suite.SupportHTTPRouteMethodMatching},
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "headers", backend: "infra-backend-v1", match: withHeaders(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), "color", "red", "shape", "circle")},
+		{namespace: "gateway-conformance-infra", suffix: "get", backend: "infra-backend-v2", match: withMethod(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), v1beta1.HTTPMethodGet)},
+	},
+	request: http.Request{Method: "GET", Path: "/", Headers: map[string]string{"color": "red", "shape": "circle"}},
+}, {
+	name:     "most-query-params",
+	features: []suite.SupportedFeature{suite.SupportHTTPRouteQueryParamMatching},
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "one", backend: "infra-backend-v1", match: withQueryParams(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), "animal", "whale")},
+		{namespace: "gateway-conformance-infra", suffix: "two", backend: "infra-backend-v2", match: withQueryParams(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), "animal", "whale", "color", "blue")},
+	},
+	request: http.Request{Path: "/?animal=whale&color=blue"},
+}, {
+	name:     "headers-over-query-params",
+	features: []suite.SupportedFeature{suite.SupportHTTPRouteQueryParamMatching},
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "query", backend: "infra-backend-v1", match: withQueryParams(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), "animal", "whale", "color", "blue")},
+		{namespace: "gateway-conformance-infra", suffix: "header", backend: "infra-backend-v2", match: withHeaders(precedencePathMatch(v1beta1.PathMatchPathPrefix, "/"), "color", "red")},
+	},
+	request: http.Request{Path: "/?animal=whale&color=blue", Headers: map[string]string{"color": "red"}},
+}, {
+	// Routes are created from last to first in alphabetical order, so that
+	// the oldest route can only take precedence by its creationTimestamp.
+	name: "oldest",
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "c", backend: "infra-backend-v1", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/")},
+		{namespace: "gateway-conformance-infra", suffix: "b", backend: "infra-backend-v2", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/")},
+		{namespace: "gateway-conformance-infra", suffix: "a", backend: "infra-backend-v3", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/")},
+	},
+	request:  http.Request{Path: "/"},
+	tieBreak: precedenceByCreationTimestamp,
+}, {
+	// Routes created at the same time are ordered by namespace and name. If
+	// they happen to be created at different times, they are ordered by
+	// creationTimestamp instead, and the test logs that the name tie-break
+	// was not exercised.
+	name: "namespace",
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "a", backend: "infra-backend-v1", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/")},
+		{namespace: "gateway-conformance-app-backend", suffix: "b", backend: "app-backend-v1", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/")},
+	},
+	request:  http.Request{Path: "/"},
+	tieBreak: precedenceByName,
+}, {
+	name: "name",
+	routes: []precedenceRoute{
+		{namespace: "gateway-conformance-infra", suffix: "b", backend: "infra-backend-v1", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/")},
+		{namespace: "gateway-conformance-infra", suffix: "a", backend: "infra-backend-v2", match: precedencePathMatch(v1beta1.PathMatchPathPrefix, "/")},
+	},
+	request:  http.Request{Path: "/"},
+	tieBreak: precedenceByName,
+}}
+
+func precedencePathMatch(pathType v1beta1.PathMatchType, value string) v1beta1.HTTPRouteMatch {
+	return v1beta1.HTTPRouteMatch{
+		Path: &v1beta1.HTTPPathMatch{Type: &pathType, Value: &value},
+	}
+}
+
+func withMethod(match v1beta1.HTTPRouteMatch, method v1beta1.HTTPMethod) v1beta1.HTTPRouteMatch {
+	match.Method = &method
+	return match
+}
+
+// withHeaders adds exact header matches, given as name and value pairs.
+func withHeaders(match v1beta1.HTTPRouteMatch, nameValues ...string) v1beta1.HTTPRouteMatch {
+	for i := 0; i+1 < len(nameValues); i += 2 {
+		match.Headers = append(match.Headers, v1beta1.HTTPHeaderMatch{
+			Name:  v1beta1.HTTPHeaderName(nameValues[i]),
+			Value: nameValues[i+1],
+		})
+	}
+	return match
+}
+
+// withQueryParams adds exact query param matches, given as name and value
+// pairs.
+func withQueryParams(match v1beta1.HTTPRouteMatch, nameValues ...string) v1beta1.HTTPRouteMatch {
+	for i := 0; i+1 < len(nameValues); i += 2 {
+		match.QueryParams = append(match.QueryParams, v1beta1.HTTPQueryParamMatch{
+			Name:  nameValues[i],
+			Value: nameValues[i+1],
+		})
+	}
+	return match
+}
+
+// precedenceWinner returns the route that takes precedence among routes that
+// all match a request, each with a single rule with a single match, along
+// with the rule it took precedence over the runner-up by. Matches take
+// precedence by, in order:
+//
+//  1. "Exact" path match.
+//  2. "Prefix" path match with largest number of characters.
+//  3. Method match.
+//  4. Largest number of header matches.
+//  5. Largest number of query param matches.
+//
+// Ties between routes are broken by the oldest creationTimestamp, then by
+// "{namespace}/{name}" in alphabetical order.
+func precedenceWinner(routes []*v1beta1.HTTPRoute) (*v1beta1.HTTPRoute, string) {
+	sorted := make([]*v1beta1.HTTPRoute, len(routes))
+	copy(sorted, routes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		before, _ := precedes(sorted[i], sorted[j])
+		return before
+	})
+	if len(sorted) < 2 {
+		return sorted[0], "being the only route"
+	}
+	_, rule := precedes(sorted[0], sorted[1])
+	return sorted[0], rule
+}
+
+// precedes returns true if route a takes precedence over route b, along with
+// the rule that decided it.
+func precedes(a, b *v1beta1.HTTPRoute) (bool, string) {
+	am, bm := a.Spec.Rules[0].Matches[0], b.Spec.Rules[0].Matches[0]
+
+	if aExact, bExact := isExactPathMatch(am), isExactPathMatch(bm); aExact != bExact {
+		return aExact, precedenceByExactPath
+	}
+	if aLen, bLen := len(*am.Path.Value), len(*bm.Path.Value); aLen != bLen {
+		return aLen > bLen, precedenceByPrefixLength
+	}
+	if aMethod, bMethod := am.Method != nil, bm.Method != nil; aMethod != bMethod {
+		return aMethod, precedenceByMethod
+	}
+	if len(am.Headers) != len(bm.Headers) {
+		return len(am.Headers) > len(bm.Headers), precedenceByHeaders
+	}
+	if len(am.QueryParams) != len(bm.QueryParams) {
+		return len(am.QueryParams) > len(bm.QueryParams), precedenceByQueryParams
+	}
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp), precedenceByCreationTimestamp
+	}
+	return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name, precedenceByName
+}
+
+func isExactPathMatch(match v1beta1.HTTPRouteMatch) bool {
+	return match.Path.Type != nil && *match.Path.Type == v1beta1.PathMatchExact
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteReferenceGrantRevocation)
+}
+
+var HTTPRouteReferenceGrantRevocation = suite.ConformanceTest{
+	ShortName:   "HTTPRouteReferenceGrantRevocation",
+	Description: "An HTTPRoute in the gateway-conformance-infra namespace should stop routing to a backendRef in the gateway-conformance-web-backend namespace when the ReferenceGrant permitting it is deleted or narrowed, and route to it again once it is granted again",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute, suite.SupportReferenceGrant},
+	Manifests:   []string{"tests/httproute-reference-grant-revocation.yaml"},
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		routeNN := types.NamespacedName{Name: "reference-grant-revocation", Namespace: "gateway-conformance-infra"}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: "gateway-conformance-infra"}
+		grantNN := types.NamespacedName{Name: "reference-grant-revocation", Namespace: "gateway-conformance-web-backend"}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		granted := http.ExpectedResponse{
+			Request:   http.Request{Host: "reference-grant-revocation.example.com", Path: "/"},
+			Response:  http.Response{StatusCode: 200},
+			Backend:   "web-backend",
+			Namespace: "gateway-conformance-web-backend",
+		}
+		revoked := http.ExpectedResponse{
+			Request:  http.Request{Host: "reference-grant-revocation.example.com", Path: "/"},
+			Response: http.Response{StatusCode: 500},
+		}
+		refNotPermitted := metav1.Condition{
+			Type:   string(v1beta1.RouteConditionResolvedRefs),
+			Status: metav1.ConditionFalse,
+			Reason: string(v1beta1.RouteReasonRefNotPermitted),
+		}
+
+		expectGranted := func(t *testing.T) {
+			kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, s.Client, s.TimeoutConfig, routeNN, gwNN)
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, granted)
+		}
+		expectRevoked := func(t *testing.T) {
+			kubernetes.HTTPRouteMustHaveCondition(t, s.Client, s.TimeoutConfig, routeNN, gwNN, refNotPermitted)
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, revoked)
+		}
+
+		t.Run("HTTP request should reach web-backend while the ReferenceGrant exists", expectGranted)
+
+		original := referenceGrantMustExist(t, s, grantNN)
+
+		t.Run("HTTP request should receive a 500 once the ReferenceGrant is deleted", func(t *testing.T) {
+			deleteReferenceGrant(t, s, grantNN)
+			expectRevoked(t)
+		})
+
+		t.Run("HTTP request should reach web-backend again once the ReferenceGrant is recreated", func(t *testing.T) {
+			createReferenceGrant(t, s, original)
+			expectGranted(t)
+		})
+
+		t.Run("HTTP request should receive a 500 once the ReferenceGrant no longer includes web-backend", func(t *testing.T) {
+			narrowed := original.Spec.DeepCopy()
+			name := v1beta1.ObjectName("web-backend-other")
+			narrowed.To[0].Name = &name
+			updateReferenceGrantSpec(t, s, grantNN, *narrowed)
+			expectRevoked(t)
+		})
+
+		t.Run("HTTP request should reach web-backend again once the ReferenceGrant includes web-backend again", func(t *testing.T) {
+			updateReferenceGrantSpec(t, s, grantNN, original.Spec)
+			expectGranted(t)
+		})
+	},
+}
+
+// referenceGrantMustExist returns the ReferenceGrant with the given name.
+func referenceGrantMustExist(t *testing.T, s *suite.ConformanceTestSuite, grantNN types.NamespacedName) *v1beta1.ReferenceGrant {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.GetTimeout)
+	defer cancel()
+
+	grant := &v1beta1.ReferenceGrant{}
+	err := s.Client.Get(ctx, grantNN, grant)
+	require.NoErrorf(t, err, "error getting ReferenceGrant %s: %v", grantNN, err)
+	return grant
+}
+
+// deleteReferenceGrant deletes the ReferenceGrant with the given name.
+func deleteReferenceGrant(t *testing.T, s *suite.ConformanceTestSuite, grantNN types.NamespacedName) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.DeleteTimeout)
+	defer cancel()
+
+	grant := &v1beta1.ReferenceGrant{ObjectMeta: metav1.ObjectMeta{Name: grantNN.Name, Namespace: grantNN.Namespace}}
+	err := s.Client.Delete(ctx, grant)
+	require.NoErrorf(t, err, "error deleting ReferenceGrant %s: %v", grantNN, err)
+}
+
+// createReferenceGrant creates a ReferenceGrant with the name, labels and spec
+// of the given one, e.g. to restore a ReferenceGrant after it was deleted.
+func createReferenceGrant(t *testing.T, s *suite.ConformanceTestSuite, from *v1beta1.ReferenceGrant) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.CreateTimeout)
+	defer cancel()
+
+	grant := &v1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      from.Name,
+			Namespace: from.Namespace,
+			Labels:    from.Labels,
+		},
+		Spec: *from.Spec.DeepCopy(),
+	}
+	err := s.Client.Create(ctx, grant)
+	require.NoErrorf(t, err, "error creating ReferenceGrant %s/%s: %v", grant.Namespace, grant.Name, err)
+}
+
+// updateReferenceGrantSpec replaces the spec of the ReferenceGrant with the
+// given name.
+func updateReferenceGrantSpec(t *testing.T, s *suite.ConformanceTestSuite, grantNN types.NamespacedName, spec v1beta1.ReferenceGrantSpec) {
+	t.Helper()
+
+	grant := referenceGrantMustExist(t, s, grantNN)
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.GetTimeout)
+	defer cancel()
+
+	grant.Spec = *spec.DeepCopy()
+	err := s.Client.Update(ctx, grant)
+	require.NoErrorf(t, err, "error updating ReferenceGrant %s: %v", grantNN, err)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteRequestMirror)
+}
+
+var HTTPRouteRequestMirror = suite.ConformanceTest{
+	ShortName:   "HTTPRouteRequestMirror",
+	Description: "An HTTPRoute with a RequestMirror filter mirrors requests to another backend while responding from the primary backend",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute, suite.SupportHTTPRouteRequestMirror},
+	Manifests:   []string{"tests/httproute-request-mirror.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "request-mirror", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		// Only the requests logged by the mirror backend once the test
+		// started are considered.
+		start := time.Now()
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		// The client must only ever see the response of the primary backend.
+		request := http.Request{
+			Path:    "/mirror",
+			Headers: map[string]string{"X-Mirror-Test": "request-mirror"},
+		}
+		expected := http.ExpectedResponse{
+			Request:   request,
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		}
+		http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expected)
+		http.ExpectMirroredRequest(t, suite.Clientset, suite.TimeoutConfig, start, ns, "infra-backend-mirror", request)
+	},
+}
@@ -33,7 +33,7 @@ func init() {
 var HTTPRouteResponseHeaderModifier = suite.ConformanceTest{
 	ShortName:   "HTTPRouteResponseHeaderModifier",
 	Description: "An HTTPRoute has response header modifier filters applied correctly",
-	Features:    []suite.SupportedFeature{suite.SupportHTTPResponseHeaderModification},
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute, suite.SupportHTTPResponseHeaderModification},
 	Manifests:   []string{"tests/httproute-response-header-modifier.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
 		ns := "gateway-conformance-infra"
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteWebSocket)
+}
+
+var HTTPRouteWebSocket = suite.ConformanceTest{
+	ShortName:   "HTTPRouteWebSocket",
+	Description: "An HTTPRoute should forward WebSocket connections, upgraded from HTTP/1.1, to its backend",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportHTTPRoute,
+		suite.SupportHTTPRouteWebSocket,
+	},
+	Manifests: []string{"tests/httproute-websocket.yaml"},
+	Parallel:  true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "websocket", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		// The WebSocket backend is applied with the route, so it may still be
+		// starting.
+		kubernetes.PodMustBeRunning(t, s.Client, s.TimeoutConfig, ns, map[string]string{"app": "infra-backend-websocket"})
+
+		t.Run("WebSocket messages should be echoed back by the backend", func(t *testing.T) {
+			http.MakeWebSocketRequestAndExpectEventuallyConsistentEcho(t, s.RoundTripper, s.TimeoutConfig, gwAddr, "websocket.example.com", "/ws", []string{
+				"hello",
+				"from the",
+				"gateway api conformance tests",
+			})
+		})
+	},
+}
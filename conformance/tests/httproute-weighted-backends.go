@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteWeightedBackends)
+}
+
+var HTTPRouteWeightedBackends = suite.ConformanceTest{
+	ShortName:   "HTTPRouteWeightedBackends",
+	Description: "A single HTTPRoute splitting traffic between backends according to their weights",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportHTTPRoute},
+	Manifests:   []string{"tests/httproute-weighted-backends.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "weighted-backends", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		testCases := []struct {
+			path    string
+			weights map[string]int32
+		}{{
+			path:    "/even",
+			weights: map[string]int32{"infra-backend-v1": 1, "infra-backend-v2": 1},
+		}, {
+			path:    "/uneven",
+			weights: map[string]int32{"infra-backend-v1": 70, "infra-backend-v2": 20, "infra-backend-v3": 10},
+		}, {
+			path:    "/zero",
+			weights: map[string]int32{"infra-backend-v1": 1, "infra-backend-v2": 1, "infra-backend-v3": 0},
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.path, func(t *testing.T) {
+				t.Parallel()
+				expected := http.ExpectedResponse{
+					Request:   http.Request{Host: "weighted-backends.example.com", Path: tc.path},
+					Namespace: ns,
+				}
+				http.MakeRequestsAndExpectWeightedBackends(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expected, http.WeightedBackends{Weights: tc.weights})
+			})
+		}
+	},
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, MeshHTTPRouteMatching)
+}
+
+var MeshHTTPRouteMatching = suite.ConformanceTest{
+	ShortName:   "MeshHTTPRouteMatching",
+	Description: "An HTTPRoute attached to a Service routes east-west traffic to backends based on the path of the request",
+	Features:    []suite.SupportedFeature{suite.SupportMesh, suite.SupportHTTPRoute},
+	Manifests:   []string{"tests/mesh-httproute-matching.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-mesh"
+		routeNN := types.NamespacedName{Name: "mesh-matching", Namespace: ns}
+		svcNN := types.NamespacedName{Name: "echo", Namespace: ns}
+		svcAddr := kubernetes.ServiceAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, svcNN, routeNN)
+
+		testCases := []http.ExpectedResponse{{
+			Request:   http.Request{Path: "/v1"},
+			Backend:   "echo-v1",
+			Namespace: ns,
+		}, {
+			Request:   http.Request{Path: "/v1/example"},
+			Backend:   "echo-v1",
+			Namespace: ns,
+		}, {
+			Request:   http.Request{Path: "/v2"},
+			Backend:   "echo-v2",
+			Namespace: ns,
+		}, {
+			// Requests to a Service with routes attached, which match none of
+			// them, are not routed to the Service.
+			Request:  http.Request{Path: "/v2/example"},
+			Response: http.Response{StatusCode: 404},
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, svcAddr, tc)
+			})
+		}
+	},
+}
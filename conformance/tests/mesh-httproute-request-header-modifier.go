@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, MeshHTTPRouteRequestHeaderModifier)
+}
+
+var MeshHTTPRouteRequestHeaderModifier = suite.ConformanceTest{
+	ShortName:   "MeshHTTPRouteRequestHeaderModifier",
+	Description: "An HTTPRoute attached to a Service has request header modifier filters applied correctly to east-west traffic",
+	Features:    []suite.SupportedFeature{suite.SupportMesh, suite.SupportHTTPRoute},
+	Manifests:   []string{"tests/mesh-httproute-request-header-modifier.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-mesh"
+		routeNN := types.NamespacedName{Name: "mesh-request-header-modifier", Namespace: ns}
+		svcNN := types.NamespacedName{Name: "echo", Namespace: ns}
+		svcAddr := kubernetes.ServiceAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, svcNN, routeNN)
+
+		testCases := []http.ExpectedResponse{{
+			Request: http.Request{
+				Path: "/set",
+				Headers: map[string]string{
+					"Some-Other-Header": "val",
+					"X-Header-Set":      "some-other-value",
+				},
+			},
+			ExpectedRequest: &http.ExpectedRequest{
+				Request: http.Request{
+					Path: "/set",
+					Headers: map[string]string{
+						"Some-Other-Header": "val",
+						"X-Header-Set":      "set-overwrites-values",
+					},
+				},
+			},
+			Backend:   "echo-v1",
+			Namespace: ns,
+		}, {
+			Request: http.Request{
+				Path: "/add",
+				Headers: map[string]string{
+					"Some-Other-Header": "val",
+					"X-Header-Add":      "some-other-value",
+				},
+			},
+			ExpectedRequest: &http.ExpectedRequest{
+				Request: http.Request{
+					Path: "/add",
+					Headers: map[string]string{
+						"Some-Other-Header": "val",
+						"X-Header-Add":      "some-other-value,add-appends-values",
+					},
+				},
+			},
+			Backend:   "echo-v1",
+			Namespace: ns,
+		}, {
+			Request: http.Request{
+				Path: "/remove",
+				Headers: map[string]string{
+					"X-Header-Remove": "val",
+				},
+			},
+			ExpectedRequest: &http.ExpectedRequest{
+				Request: http.Request{
+					Path: "/remove",
+				},
+				AbsentHeaders: []string{"X-Header-Remove"},
+			},
+			Backend:   "echo-v1",
+			Namespace: ns,
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, svcAddr, tc)
+			})
+		}
+	},
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, MeshHTTPRouteWeightedBackends)
+}
+
+var MeshHTTPRouteWeightedBackends = suite.ConformanceTest{
+	ShortName:   "MeshHTTPRouteWeightedBackends",
+	Description: "An HTTPRoute attached to a Service splitting east-west traffic between backends according to their weights",
+	Features:    []suite.SupportedFeature{suite.SupportMesh, suite.SupportHTTPRoute},
+	Manifests:   []string{"tests/mesh-httproute-weighted-backends.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-mesh"
+		routeNN := types.NamespacedName{Name: "mesh-weighted-backends", Namespace: ns}
+		svcNN := types.NamespacedName{Name: "echo", Namespace: ns}
+		svcAddr := kubernetes.ServiceAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, svcNN, routeNN)
+
+		testCases := []struct {
+			path    string
+			weights map[string]int32
+		}{{
+			path:    "/even",
+			weights: map[string]int32{"echo-v1": 1, "echo-v2": 1},
+		}, {
+			path:    "/uneven",
+			weights: map[string]int32{"echo-v1": 80, "echo-v2": 20},
+		}, {
+			path:    "/zero",
+			weights: map[string]int32{"echo-v1": 1, "echo-v2": 0},
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			t.Run(tc.path, func(t *testing.T) {
+				t.Parallel()
+				expected := http.ExpectedResponse{
+					Request:   http.Request{Path: tc.path},
+					Namespace: ns,
+				}
+				http.MakeRequestsAndExpectWeightedBackends(t, suite.RoundTripper, suite.TimeoutConfig, svcAddr, expected, http.WeightedBackends{Weights: tc.weights})
+			})
+		}
+	},
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/stream"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TCPRouteListenerPort)
+}
+
+var TCPRouteListenerPort = suite.ConformanceTest{
+	ShortName:   "TCPRouteListenerPort",
+	Description: "A TCPRoute attaches to the Gateway listener selected by the port of its parentRef",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportTCPRoute, suite.SupportRouteDestinationPortMatching},
+	Manifests:   []string{"tests/tcproute-listener-port.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		gwNN := types.NamespacedName{Name: "gateway-tcproute-port", Namespace: ns}
+		portRouteNN := types.NamespacedName{Name: "tcproute-port", Namespace: ns}
+
+		// The listener ports may have been changed when applying the
+		// manifests, so the parentRef port must be updated to match.
+		port := kubernetes.GatewayListenerPort(t, s.Client, s.TimeoutConfig, gwNN, "tcp-2")
+		ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.CreateTimeout)
+		defer cancel()
+		route := &v1alpha2.TCPRoute{}
+		require.NoError(t, s.Client.Get(ctx, portRouteNN, route), "error fetching TCPRoute")
+		original := route.DeepCopy()
+		route.Spec.ParentRefs[0].Port = &port
+		require.NoError(t, s.Client.Patch(ctx, route, client.MergeFrom(original)), "error patching TCPRoute parentRef port")
+
+		gwAddr := kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN), portRouteNN)
+		kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "tcp-1"), types.NamespacedName{Name: "tcproute-section-name", Namespace: ns})
+
+		testCases := []struct {
+			listener string
+			backend  string
+		}{
+			{listener: "tcp-1", backend: "tcp-backend-v1"},
+			{listener: "tcp-2", backend: "tcp-backend-v2"},
+		}
+		for _, tc := range testCases {
+			tc := tc
+			t.Run("TCP connection to listener "+tc.listener+" should reach "+tc.backend, func(t *testing.T) {
+				addr := kubernetes.GatewayListenerAddress(t, s.Client, s.TimeoutConfig, gwNN, gwAddr, tc.listener)
+				stream.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, stream.TCP, addr, stream.ExpectedResponse{Backend: tc.backend})
+			})
+		}
+	},
+}
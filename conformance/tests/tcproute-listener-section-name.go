@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/stream"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TCPRouteListenerSectionName)
+}
+
+var TCPRouteListenerSectionName = suite.ConformanceTest{
+	ShortName:   "TCPRouteListenerSectionName",
+	Description: "TCPRoutes attach to the Gateway listeners selected by the sectionName of their parentRefs",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportTCPRoute},
+	Manifests:   []string{"tests/tcproute-listener-section-name.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		gwNN := types.NamespacedName{Name: "gateway-tcproute-section-name", Namespace: ns}
+
+		gwAddr := kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "tcp-1"), types.NamespacedName{Name: "tcproute-section-name-1", Namespace: ns})
+		kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "tcp-2"), types.NamespacedName{Name: "tcproute-section-name-2", Namespace: ns})
+
+		testCases := []struct {
+			listener string
+			backend  string
+		}{
+			{listener: "tcp-1", backend: "tcp-backend-v1"},
+			{listener: "tcp-2", backend: "tcp-backend-v2"},
+		}
+		for _, tc := range testCases {
+			tc := tc
+			t.Run("TCP connection to listener "+tc.listener+" should reach "+tc.backend, func(t *testing.T) {
+				addr := kubernetes.GatewayListenerAddress(t, s.Client, s.TimeoutConfig, gwNN, gwAddr, tc.listener)
+				stream.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, stream.TCP, addr, stream.ExpectedResponse{Backend: tc.backend})
+			})
+		}
+	},
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/stream"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TCPRouteSimpleSameNamespace)
+}
+
+var TCPRouteSimpleSameNamespace = suite.ConformanceTest{
+	ShortName:   "TCPRouteSimpleSameNamespace",
+	Description: "A single TCPRoute in the gateway-conformance-infra namespace attaches to a Gateway in the same namespace",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportTCPRoute},
+	Manifests:   []string{"tests/tcproute-simple-same-namespace.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "tcproute-simple", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "gateway-tcproute", Namespace: ns}
+
+		gwAddr := kubernetes.GatewayAndTCPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		t.Run("TCP connection matching TCPRoute should reach its backend", func(t *testing.T) {
+			stream.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, stream.TCP, gwAddr, stream.ExpectedResponse{Backend: "tcp-backend-v1"})
+		})
+	},
+}
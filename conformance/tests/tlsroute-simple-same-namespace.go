@@ -41,8 +41,9 @@ func init() {
 var TLSRouteSimpleSameNamespace = suite.ConformanceTest{
 	ShortName:   "TLSRouteSimpleSameNamespace",
 	Description: "A single TLSRoute in the gateway-conformance-infra namespace attaches to a Gateway in the same namespace",
-	Features:    []suite.SupportedFeature{suite.SupportTLSRoute},
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportTLSRoute},
 	Manifests:   []string{"tests/tlsroute-simple-same-namespace.yaml"},
+	Parallel:    true,
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
 		ns := v1beta1.Namespace("gateway-conformance-infra")
 		routeNN := types.NamespacedName{Name: "gateway-conformance-infra-test", Namespace: string(ns)}
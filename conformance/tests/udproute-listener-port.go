@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/stream"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, UDPRouteListenerPort)
+}
+
+var UDPRouteListenerPort = suite.ConformanceTest{
+	ShortName:   "UDPRouteListenerPort",
+	Description: "A UDPRoute attaches to the Gateway listener selected by the port of its parentRef",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportUDPRoute, suite.SupportRouteDestinationPortMatching},
+	Manifests:   []string{"tests/udproute-listener-port.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		gwNN := types.NamespacedName{Name: "gateway-udproute-port", Namespace: ns}
+		portRouteNN := types.NamespacedName{Name: "udproute-port", Namespace: ns}
+
+		// The listener ports may have been changed when applying the
+		// manifests, so the parentRef port must be updated to match.
+		port := kubernetes.GatewayListenerPort(t, s.Client, s.TimeoutConfig, gwNN, "udp-2")
+		ctx, cancel := context.WithTimeout(context.Background(), s.TimeoutConfig.CreateTimeout)
+		defer cancel()
+		route := &v1alpha2.UDPRoute{}
+		require.NoError(t, s.Client.Get(ctx, portRouteNN, route), "error fetching UDPRoute")
+		original := route.DeepCopy()
+		route.Spec.ParentRefs[0].Port = &port
+		require.NoError(t, s.Client.Patch(ctx, route, client.MergeFrom(original)), "error patching UDPRoute parentRef port")
+
+		gwAddr := kubernetes.GatewayAndUDPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN), portRouteNN)
+		kubernetes.GatewayAndUDPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "udp-1"), types.NamespacedName{Name: "udproute-section-name", Namespace: ns})
+
+		testCases := []struct {
+			listener string
+			backend  string
+		}{
+			{listener: "udp-1", backend: "udp-backend-v1"},
+			{listener: "udp-2", backend: "udp-backend-v2"},
+		}
+		for _, tc := range testCases {
+			tc := tc
+			t.Run("UDP datagram to listener "+tc.listener+" should reach "+tc.backend, func(t *testing.T) {
+				addr := kubernetes.GatewayListenerAddress(t, s.Client, s.TimeoutConfig, gwNN, gwAddr, tc.listener)
+				stream.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, stream.UDP, addr, stream.ExpectedResponse{Backend: tc.backend})
+			})
+		}
+	},
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/stream"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, UDPRouteListenerSectionName)
+}
+
+var UDPRouteListenerSectionName = suite.ConformanceTest{
+	ShortName:   "UDPRouteListenerSectionName",
+	Description: "UDPRoutes attach to the Gateway listeners selected by the sectionName of their parentRefs",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportUDPRoute},
+	Manifests:   []string{"tests/udproute-listener-section-name.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		gwNN := types.NamespacedName{Name: "gateway-udproute-section-name", Namespace: ns}
+
+		gwAddr := kubernetes.GatewayAndUDPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "udp-1"), types.NamespacedName{Name: "udproute-section-name-1", Namespace: ns})
+		kubernetes.GatewayAndUDPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "udp-2"), types.NamespacedName{Name: "udproute-section-name-2", Namespace: ns})
+
+		testCases := []struct {
+			listener string
+			backend  string
+		}{
+			{listener: "udp-1", backend: "udp-backend-v1"},
+			{listener: "udp-2", backend: "udp-backend-v2"},
+		}
+		for _, tc := range testCases {
+			tc := tc
+			t.Run("UDP datagram to listener "+tc.listener+" should reach "+tc.backend, func(t *testing.T) {
+				addr := kubernetes.GatewayListenerAddress(t, s.Client, s.TimeoutConfig, gwNN, gwAddr, tc.listener)
+				stream.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, stream.UDP, addr, stream.ExpectedResponse{Backend: tc.backend})
+			})
+		}
+	},
+}
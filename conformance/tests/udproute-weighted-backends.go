@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/stream"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, UDPRouteWeightedBackends)
+}
+
+var UDPRouteWeightedBackends = suite.ConformanceTest{
+	ShortName:   "UDPRouteWeightedBackends",
+	Description: "UDPRoutes split traffic between their backends according to their weights, and never send traffic to backends with a weight of zero",
+	Features:    []suite.SupportedFeature{suite.SupportGateway, suite.SupportUDPRoute},
+	Manifests:   []string{"tests/udproute-weighted-backends.yaml"},
+	Parallel:    true,
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		gwNN := types.NamespacedName{Name: "gateway-udproute-weighted", Namespace: ns}
+
+		gwAddr := kubernetes.GatewayAndUDPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "even"), types.NamespacedName{Name: "udproute-weighted-even", Namespace: ns})
+		kubernetes.GatewayAndUDPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN, "zero"), types.NamespacedName{Name: "udproute-weighted-zero", Namespace: ns})
+
+		t.Run("UDP datagrams to backends with equal weights should be split evenly between them", func(t *testing.T) {
+			addr := kubernetes.GatewayListenerAddress(t, s.Client, s.TimeoutConfig, gwNN, gwAddr, "even")
+			stream.MakeRequestsAndExpectWeightedBackends(t, s.RoundTripper, s.TimeoutConfig, stream.UDP, addr, http.WeightedBackends{
+				Weights: map[string]int32{"udp-backend-v1": 1, "udp-backend-v2": 1},
+			})
+		})
+
+		t.Run("UDP datagrams should never reach a backend with a weight of zero", func(t *testing.T) {
+			addr := kubernetes.GatewayListenerAddress(t, s.Client, s.TimeoutConfig, gwNN, gwAddr, "zero")
+			stream.MakeRequestsAndExpectWeightedBackends(t, s.RoundTripper, s.TimeoutConfig, stream.UDP, addr, http.WeightedBackends{
+				Weights: map[string]int32{"udp-backend-v1": 1, "udp-backend-v2": 0},
+			})
+		})
+	},
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConvergenceMetrics are the timings of a successful convergence, i.e. of the
+// attempts made until a test got a consistent response.
+type ConvergenceMetrics struct {
+	// TestName is the name of the test, or subtest, which awaited
+	// convergence.
+	TestName string
+
+	// TimeToFirstSuccess is the time until the first successful attempt.
+	TimeToFirstSuccess time.Duration
+
+	// TimeToConsistency is the time until the last of the successful
+	// attempts in a row that made convergence.
+	TimeToConsistency time.Duration
+
+	// Attempts is the number of attempts made.
+	Attempts int
+}
+
+// ConvergenceRecorder collects the ConvergenceMetrics of the convergences
+// awaited with a TimeoutConfig. It is safe for concurrent use.
+type ConvergenceRecorder struct {
+	lock    sync.Mutex
+	metrics []ConvergenceMetrics
+}
+
+// Record adds the metrics of a convergence.
+func (r *ConvergenceRecorder) Record(metrics ConvergenceMetrics) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.metrics = append(r.metrics, metrics)
+}
+
+// Take returns the metrics recorded for the test with the given name and its
+// subtests, and forgets them.
+func (r *ConvergenceRecorder) Take(testName string) []ConvergenceMetrics {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var taken, kept []ConvergenceMetrics
+	for _, metrics := range r.metrics {
+		if metrics.TestName == testName || strings.HasPrefix(metrics.TestName, testName+"/") {
+			taken = append(taken, metrics)
+		} else {
+			kept = append(kept, metrics)
+		}
+	}
+	r.metrics = kept
+	return taken
+}
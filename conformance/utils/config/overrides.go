@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// TimeoutPresetName is the name of a set of timeouts tuned for a kind of
+// environment.
+type TimeoutPresetName string
+
+const (
+	// TimeoutPresetDefault is the DefaultTimeoutConfig.
+	TimeoutPresetDefault TimeoutPresetName = "default"
+
+	// TimeoutPresetLocal allows more time for Pods and Gateways to be ready,
+	// for local clusters such as kind, where images are pulled and containers
+	// scheduled on a single, often busy, machine.
+	TimeoutPresetLocal TimeoutPresetName = "local"
+
+	// TimeoutPresetCloudLB allows more time for Gateways to get an address
+	// and be programmed, for implementations provisioning cloud load
+	// balancers, which can take minutes.
+	TimeoutPresetCloudLB TimeoutPresetName = "cloud-lb"
+)
+
+// TimeoutPreset returns the TimeoutConfig of the given preset.
+func TimeoutPreset(name TimeoutPresetName) (TimeoutConfig, error) {
+	timeoutConfig := DefaultTimeoutConfig()
+	switch name {
+	case TimeoutPresetDefault:
+	case TimeoutPresetLocal:
+		timeoutConfig.CreateTimeout = 120 * time.Second
+		timeoutConfig.GWCMustBeAccepted = 300 * time.Second
+		timeoutConfig.NamespacesMustBeReady = 600 * time.Second
+	case TimeoutPresetCloudLB:
+		timeoutConfig.GatewayMustHaveAddress = 600 * time.Second
+		timeoutConfig.GatewayMustHaveCondition = 300 * time.Second
+		timeoutConfig.GatewayStatusMustHaveListeners = 300 * time.Second
+		timeoutConfig.HTTPRouteMustHaveCondition = 120 * time.Second
+		timeoutConfig.HTTPRouteMustNotHaveParents = 120 * time.Second
+		timeoutConfig.RouteMustHaveParents = 120 * time.Second
+		timeoutConfig.NamespacesMustBeReady = 900 * time.Second
+		timeoutConfig.RequestTimeout = 20 * time.Second
+	default:
+		return TimeoutConfig{}, fmt.Errorf("unknown timeout preset %q, valid presets are %s, %s and %s", name, TimeoutPresetDefault, TimeoutPresetLocal, TimeoutPresetCloudLB)
+	}
+	return timeoutConfig, nil
+}
+
+// LoadTimeoutConfigFile overrides the fields of the TimeoutConfig set in the
+// YAML file at the given path, e.g.
+//
+//	gatewayMustHaveAddress: 5m
+//	requiredConsecutiveSuccesses: 5
+//	convergenceBackoff:
+//	  initial: 500ms
+func LoadTimeoutConfigFile(timeoutConfig *TimeoutConfig, path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return fmt.Errorf("error parsing timeout config %s: %w", path, err)
+	}
+
+	overrides := map[string]string{}
+	flattenOverrides(overrides, "", values)
+	if err := ApplyTimeoutOverrides(timeoutConfig, overrides); err != nil {
+		return fmt.Errorf("error loading timeout config %s: %w", path, err)
+	}
+	return nil
+}
+
+func flattenOverrides(overrides map[string]string, prefix string, values map[string]interface{}) {
+	for key, value := range values {
+		if nested, ok := value.(map[string]interface{}); ok {
+			flattenOverrides(overrides, prefix+key+".", nested)
+			continue
+		}
+		overrides[prefix+key] = fmt.Sprint(value)
+	}
+}
+
+// ParseTimeoutOverrides parses a comma-separated list of name=value pairs,
+// e.g. "maxTimeToConsistency=45s,convergenceBackoff.jitter=0.2".
+func ParseTimeoutOverrides(s string) (map[string]string, error) {
+	overrides := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid timeout override %q, expected name=value", pair)
+		}
+		overrides[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return overrides, nil
+}
+
+// ApplyTimeoutOverrides sets the fields of the TimeoutConfig named by the keys
+// of the overrides to their values. Names are matched case-insensitively
+// against the field names, with nested fields separated by dots, e.g.
+// "convergenceBackoff.initial". Durations are parsed with time.ParseDuration.
+func ApplyTimeoutOverrides(timeoutConfig *TimeoutConfig, overrides map[string]string) error {
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field, err := timeoutField(reflect.ValueOf(timeoutConfig).Elem(), name)
+		if err != nil {
+			return err
+		}
+		if err := setTimeoutField(field, overrides[name]); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func timeoutField(v reflect.Value, name string) (reflect.Value, error) {
+	for _, part := range strings.Split(name, ".") {
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("unknown timeout %q", name)
+		}
+		field := v.FieldByNameFunc(func(fieldName string) bool {
+			return strings.EqualFold(fieldName, part)
+		})
+		if !field.IsValid() {
+			return reflect.Value{}, fmt.Errorf("unknown timeout %q", name)
+		}
+		v = field
+	}
+	return v, nil
+}
+
+func setTimeoutField(field reflect.Value, value string) error {
+	switch {
+	case field.Type() == reflect.TypeOf(time.Duration(0)):
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+	case field.Kind() == reflect.Int:
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(i))
+	case field.Kind() == reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("%s fields can't be set", field.Type())
+	}
+	return nil
+}
+
+// conformanceLimits are the "Max value for conformant implementation" of the
+// fields of TimeoutConfig which have one.
+var conformanceLimits = map[string]time.Duration{
+	"MaxTimeToConsistency": 30 * time.Second,
+}
+
+// ConformanceLimitViolations returns a description of each field of the
+// TimeoutConfig set above its max value for conformant implementations. Runs
+// with such timeouts can't be used to claim conformance.
+func ConformanceLimitViolations(timeoutConfig TimeoutConfig) []string {
+	v := reflect.ValueOf(timeoutConfig)
+	var violations []string
+	for name, limit := range conformanceLimits {
+		value := time.Duration(v.FieldByName(name).Int())
+		if value > limit {
+			violations = append(violations, fmt.Sprintf("%s is %v, above the max value of %v for conformant implementations", name, value, limit))
+		}
+	}
+	sort.Strings(violations)
+	return violations
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutPreset(t *testing.T) {
+	for _, name := range []TimeoutPresetName{TimeoutPresetDefault, TimeoutPresetLocal, TimeoutPresetCloudLB} {
+		timeoutConfig, err := TimeoutPreset(name)
+		require.NoError(t, err)
+		require.Empty(t, ConformanceLimitViolations(timeoutConfig), "preset %s must be usable to claim conformance", name)
+	}
+
+	timeoutConfig, err := TimeoutPreset(TimeoutPresetCloudLB)
+	require.NoError(t, err)
+	require.Equal(t, 600*time.Second, timeoutConfig.GatewayMustHaveAddress)
+
+	_, err = TimeoutPreset("missing")
+	require.Error(t, err)
+}
+
+func TestApplyTimeoutOverrides(t *testing.T) {
+	overrides, err := ParseTimeoutOverrides("maxTimeToConsistency=45s, RequiredConsecutiveSuccesses=5,convergencebackoff.jitter=0.2")
+	require.NoError(t, err)
+
+	timeoutConfig := DefaultTimeoutConfig()
+	require.NoError(t, ApplyTimeoutOverrides(&timeoutConfig, overrides))
+	require.Equal(t, 45*time.Second, timeoutConfig.MaxTimeToConsistency)
+	require.Equal(t, 5, timeoutConfig.RequiredConsecutiveSuccesses)
+	require.Equal(t, 0.2, timeoutConfig.ConvergenceBackoff.Jitter)
+	require.Equal(t, []string{"MaxTimeToConsistency is 45s, above the max value of 30s for conformant implementations"}, ConformanceLimitViolations(timeoutConfig))
+
+	_, err = ParseTimeoutOverrides("maxTimeToConsistency")
+	require.Error(t, err)
+	require.Error(t, ApplyTimeoutOverrides(&timeoutConfig, map[string]string{"missing": "1s"}))
+	require.Error(t, ApplyTimeoutOverrides(&timeoutConfig, map[string]string{"createTimeout": "60"}), "durations must have a unit")
+	require.Error(t, ApplyTimeoutOverrides(&timeoutConfig, map[string]string{"convergenceBackoff": "1s"}))
+}
+
+func TestLoadTimeoutConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "timeouts.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+gatewayMustHaveAddress: 5m
+requiredConsecutiveSuccesses: 4
+convergenceBackoff:
+  initial: 500ms
+  factor: 2
+`), 0o600))
+
+	timeoutConfig := DefaultTimeoutConfig()
+	require.NoError(t, LoadTimeoutConfigFile(&timeoutConfig, path))
+	require.Equal(t, 5*time.Minute, timeoutConfig.GatewayMustHaveAddress)
+	require.Equal(t, 4, timeoutConfig.RequiredConsecutiveSuccesses)
+	require.Equal(t, 500*time.Millisecond, timeoutConfig.ConvergenceBackoff.Initial)
+	require.Equal(t, 2.0, timeoutConfig.ConvergenceBackoff.Factor)
+	require.Equal(t, DefaultTimeoutConfig().CreateTimeout, timeoutConfig.CreateTimeout)
+}
@@ -35,6 +35,10 @@ type TimeoutConfig struct {
 	// Max value for conformant implementation: None
 	GatewayMustHaveAddress time.Duration
 
+	// GatewayMustHaveCondition represents the maximum time for a Gateway, or one of its listeners, to have the supplied Condition.
+	// Max value for conformant implementation: None
+	GatewayMustHaveCondition time.Duration
+
 	// GatewayStatusMustHaveListeners represents the maximum time for a Gateway to have listeners in status that match the expected listeners.
 	// Max value for conformant implementation: None
 	GatewayStatusMustHaveListeners time.Duration
@@ -78,6 +82,70 @@ type TimeoutConfig struct {
 	// to consider a response "consistent" before making additional assertions on the response body.
 	// If this number is not reached within MaxTimeToConsistency, the test will fail.
 	RequiredConsecutiveSuccesses int
+
+	// ConvergenceBackoff is the delay between the attempts to get a
+	// consistent response that did not succeed.
+	ConvergenceBackoff Backoff
+
+	// Convergences, when set, records the metrics of the convergences
+	// awaited with this config. It is set by the conformance suite, which
+	// includes them in its report, and is not a timeout: it can't be set from
+	// timeout config files or overrides.
+	Convergences *ConvergenceRecorder `json:"-"`
+}
+
+// Backoff is an exponential backoff with jitter. A zero Initial, Max or Factor
+// is replaced with its default value.
+type Backoff struct {
+	// Initial is the delay after the first attempt that did not succeed.
+	Initial time.Duration
+
+	// Max caps the delay between attempts, before jitter is applied.
+	Max time.Duration
+
+	// Factor multiplies the delay after each attempt that did not succeed.
+	// A Factor of 1 keeps the delay constant.
+	Factor float64
+
+	// Jitter is the maximum fraction of the delay that is randomly added to
+	// it, e.g. 0.1 for up to 10% more.
+	Jitter float64
+}
+
+// Delay returns the delay after the given number of consecutive attempts that
+// did not succeed, with the jitter added based on rand, a number in [0, 1).
+func (b Backoff) Delay(failures int, rand float64) time.Duration {
+	b = b.withDefaults()
+	delay := float64(b.Initial)
+	for i := 1; i < failures && delay < float64(b.Max); i++ {
+		delay *= b.Factor
+	}
+	if delay > float64(b.Max) {
+		delay = float64(b.Max)
+	}
+	return time.Duration(delay * (1 + b.Jitter*rand))
+}
+
+// ConstantBackoff returns a Backoff with the same delay after every attempt
+// that did not succeed, and no jitter.
+func ConstantBackoff(delay time.Duration) Backoff {
+	return Backoff{Initial: delay, Max: delay, Factor: 1}
+}
+
+// withDefaults returns the Backoff with its zero Initial, Max and Factor set
+// to the values of the default ConvergenceBackoff.
+func (b Backoff) withDefaults() Backoff {
+	defaultBackoff := DefaultTimeoutConfig().ConvergenceBackoff
+	if b.Initial == 0 {
+		b.Initial = defaultBackoff.Initial
+	}
+	if b.Max == 0 {
+		b.Max = defaultBackoff.Max
+	}
+	if b.Factor == 0 {
+		b.Factor = defaultBackoff.Factor
+	}
+	return b
 }
 
 // DefaultTimeoutConfig populates a TimeoutConfig with the default values.
@@ -87,6 +155,7 @@ func DefaultTimeoutConfig() TimeoutConfig {
 		DeleteTimeout:                  10 * time.Second,
 		GetTimeout:                     10 * time.Second,
 		GatewayMustHaveAddress:         180 * time.Second,
+		GatewayMustHaveCondition:       60 * time.Second,
 		GatewayStatusMustHaveListeners: 60 * time.Second,
 		GWCMustBeAccepted:              180 * time.Second,
 		HTTPRouteMustNotHaveParents:    60 * time.Second,
@@ -97,6 +166,12 @@ func DefaultTimeoutConfig() TimeoutConfig {
 		NamespacesMustBeReady:          300 * time.Second,
 		RequestTimeout:                 10 * time.Second,
 		RequiredConsecutiveSuccesses:   3,
+		ConvergenceBackoff: Backoff{
+			Initial: time.Second,
+			Max:     5 * time.Second,
+			Factor:  1.5,
+			Jitter:  0.1,
+		},
 	}
 }
 
@@ -114,6 +189,9 @@ func SetupTimeoutConfig(timeoutConfig *TimeoutConfig) {
 	if timeoutConfig.GatewayMustHaveAddress == 0 {
 		timeoutConfig.GatewayMustHaveAddress = defaultTimeoutConfig.GatewayMustHaveAddress
 	}
+	if timeoutConfig.GatewayMustHaveCondition == 0 {
+		timeoutConfig.GatewayMustHaveCondition = defaultTimeoutConfig.GatewayMustHaveCondition
+	}
 	if timeoutConfig.GatewayStatusMustHaveListeners == 0 {
 		timeoutConfig.GatewayStatusMustHaveListeners = defaultTimeoutConfig.GatewayStatusMustHaveListeners
 	}
@@ -141,4 +219,5 @@ func SetupTimeoutConfig(timeoutConfig *TimeoutConfig) {
 	if timeoutConfig.RequestTimeout == 0 {
 		timeoutConfig.RequestTimeout = defaultTimeoutConfig.RequestTimeout
 	}
+	timeoutConfig.ConvergenceBackoff = timeoutConfig.ConvergenceBackoff.withDefaults()
 }
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	backoff := Backoff{Initial: time.Second, Max: 3 * time.Second, Factor: 2, Jitter: 0.5}
+
+	testCases := []struct {
+		failures int
+		rand     float64
+		expected time.Duration
+	}{
+		{failures: 1, rand: 0, expected: time.Second},
+		{failures: 2, rand: 0, expected: 2 * time.Second},
+		{failures: 3, rand: 0, expected: 3 * time.Second},
+		{failures: 10, rand: 0, expected: 3 * time.Second},
+		{failures: 1, rand: 0.5, expected: 1250 * time.Millisecond},
+	}
+
+	for _, tc := range testCases {
+		if delay := backoff.Delay(tc.failures, tc.rand); delay != tc.expected {
+			t.Errorf("Delay(%d, %v) = %v, expected %v", tc.failures, tc.rand, delay, tc.expected)
+		}
+	}
+}
+
+func TestZeroBackoffDelay(t *testing.T) {
+	// A zero Backoff uses the default ConvergenceBackoff rather than
+	// retrying without any delay.
+	var backoff Backoff
+	if delay := backoff.Delay(1, 0); delay != time.Second {
+		t.Errorf("Delay(1, 0) = %v, expected %v", delay, time.Second)
+	}
+	if delay := backoff.Delay(3, 0); delay != 2250*time.Millisecond {
+		t.Errorf("Delay(3, 0) = %v, expected %v", delay, 2250*time.Millisecond)
+	}
+}
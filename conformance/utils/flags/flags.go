@@ -29,5 +29,27 @@ var (
 	CleanupBaseResources       = flag.Bool("cleanup-base-resources", true, "Whether to cleanup base test resources after the run")
 	SupportedFeatures          = flag.String("supported-features", "", "Supported features included in conformance tests suites")
 	ExemptFeatures             = flag.String("exempt-features", "", "Exempt Features excluded from conformance tests suites")
+	SkipTests                  = flag.String("skip-tests", "", "Comma-separated list of the names of tests to skip")
 	EnableAllSupportedFeatures = flag.Bool("all-features", false, "Whether to enable all supported features for conformance tests")
+	ConformanceProfiles        = flag.String("conformance-profiles", "", "Comma-separated list of the conformance profiles to run tests for, e.g. HTTP,TLS")
+	ImplementationOrganization = flag.String("organization", "", "Implementation's Organization to issue conformance to")
+	ImplementationProject      = flag.String("project", "", "Implementation's project name")
+	ImplementationURL          = flag.String("url", "", "Implementation's url")
+	ImplementationVersion      = flag.String("version", "", "Implementation's version")
+	ImplementationContact      = flag.String("contact", "", "Comma-separated list of contact information for the maintainers")
+	ReportOutput               = flag.String("report-output", "", "The file where to write the conformance report (requires the experimental build tag)")
+	RunTest                    = flag.String("run-test", "", "Name of a single test to run, instead of the whole conformance test suite")
+	ListTests                  = flag.Bool("list-tests", false, "Whether to list the conformance tests instead of running them, without connecting to the cluster")
+	ListTestsFormat            = flag.String("list-tests-format", "text", "Format of the list of tests printed in -list-tests mode, either text or json")
+	UsableNetworkAddresses     = flag.String("usable-network-addresses", "", "Comma-separated list of IP addresses or hostnames that can be requested for Gateways, for the GatewayStaticAddresses tests")
+	UnusableNetworkAddresses   = flag.String("unusable-network-addresses", "", "Comma-separated list of IP addresses or hostnames that can't be assigned to Gateways, for the GatewayStaticAddresses tests")
+	FailOnDrift                = flag.Bool("fail-on-drift", false, "Whether to fail tests when the implementation changes the spec of the resources they applied, instead of only logging it")
+	ArtifactsDir               = flag.String("artifacts-dir", "", "Directory where the Gateway API objects, Events and last round trips are written when a test fails, no artifacts are written when empty")
+	ArtifactsRoundTrips        = flag.Int("artifacts-round-trips", 50, "Number of the last round trips written to the artifacts of failed tests")
+	RouteProgrammingLatency    = flag.Int("route-programming-latency-samples", 0, "Number of route changes to measure the route programming latency of after the tests have run, no measurement is made when 0")
+	TimeoutPreset              = flag.String("timeout-preset", "default", "Preset of timeouts to start from, one of default, local or cloud-lb")
+	TimeoutConfigFile          = flag.String("timeout-config", "", "YAML file overriding fields of the timeout config, e.g. 'gatewayMustHaveAddress: 5m'")
+	Timeouts                   = flag.String("timeouts", "", "Comma-separated list of name=value pairs overriding fields of the timeout config, applied after -timeout-config, e.g. maxTimeToConsistency=45s")
+	Mesh                       = flag.Bool("mesh", false, "Whether to run the mesh tests, with routes attached to Services and requests made from a client Pod, instead of the tests relying on a GatewayClass")
+	Parallelism                = flag.Int("parallelism", 1, "Maximum number of tests marked as parallel to run at the same time, values lower than 2 disable parallel runs")
 )
@@ -0,0 +1,328 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpc contains helpers to make gRPC requests to the echo service of
+// the gRPC backends of the base manifests, e.g. for GRPCRoute conformance
+// tests. The echo service is defined in conformance/echo-basic/grpcecho.proto.
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	pb "sigs.k8s.io/gateway-api/conformance/echo-basic/grpcecho"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+)
+
+const (
+	// EchoService is the fully qualified name of the echo service.
+	EchoService = "gateway_api_conformance.echo_basic.grpcecho.GrpcEcho"
+
+	// EchoMethod is the default method of the echo service.
+	EchoMethod = "Echo"
+
+	// EchoTwoMethod behaves like EchoMethod, but lives at a different method
+	// name to emulate a service with more than one method.
+	EchoTwoMethod = "EchoTwo"
+
+	// EchoThreeMethod is intentionally left unimplemented by the echo
+	// service.
+	EchoThreeMethod = "EchoThree"
+)
+
+// RoundTripper is an interface used to make gRPC requests within conformance
+// tests. This can be overridden with custom implementations whenever
+// necessary.
+type RoundTripper interface {
+	CaptureRoundTrip(Request) (*CapturedResponse, error)
+}
+
+// Request is the primary input for making a gRPC request to the echo service.
+type Request struct {
+	// Address is the host:port to connect to.
+	Address string
+	// Authority sets the :authority pseudo-header of the request, if set.
+	Authority string
+	// Method is the method of the echo service to call, defaults to
+	// EchoMethod.
+	Method string
+	// Metadata are added to the outgoing request.
+	Metadata map[string]string
+}
+
+// CapturedResponse contains the response received from the echo service. The
+// Response is only set when Code is OK.
+type CapturedResponse struct {
+	Code     codes.Code
+	Headers  metadata.MD
+	Trailers metadata.MD
+	Response *pb.EchoResponse
+}
+
+// DefaultRoundTripper is the default implementation of a gRPC RoundTripper.
+// It will be used if a custom implementation is not specified.
+type DefaultRoundTripper struct {
+	Debug         bool
+	TimeoutConfig config.TimeoutConfig
+}
+
+// CaptureRoundTrip makes a request with the provided parameters over a new
+// plaintext HTTP/2 connection and returns the response of the echo service.
+// An error will be returned if the request cannot be made, but not if a gRPC
+// error status is received.
+func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.TimeoutConfig.RequestTimeout)
+	defer cancel()
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if request.Authority != "" {
+		dialOpts = append(dialOpts, grpc.WithAuthority(request.Authority))
+	}
+	conn, err := grpc.DialContext(ctx, request.Address, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if len(request.Metadata) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(request.Metadata))
+	}
+
+	method := FullMethod(request.Method)
+	if d.Debug {
+		fmt.Printf("Sending gRPC request to %s%s with metadata %v\n\n", request.Address, method, request.Metadata)
+	}
+
+	cRes := &CapturedResponse{Headers: metadata.MD{}, Trailers: metadata.MD{}}
+	res := &pb.EchoResponse{}
+	err = conn.Invoke(ctx, method, &pb.EchoRequest{}, res, grpc.Header(&cRes.Headers), grpc.Trailer(&cRes.Trailers))
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			return nil, err
+		}
+		cRes.Code = st.Code()
+	} else {
+		cRes.Code = codes.OK
+		cRes.Response = res
+	}
+
+	if d.Debug {
+		fmt.Printf("Received gRPC response with code %s:\n%v\n\n", cRes.Code, cRes.Response)
+	}
+
+	return cRes, nil
+}
+
+// FullMethod returns the fully qualified name of the given method of the echo
+// service, defaulting to EchoMethod.
+func FullMethod(method string) string {
+	if method == "" {
+		method = EchoMethod
+	}
+	return fmt.Sprintf("/%s/%s", EchoService, method)
+}
+
+// ExpectedResponse defines the response expected for a given request.
+type ExpectedResponse struct {
+	// Request defines the request to make. The Address is set to the
+	// Gateway address by the helpers of this package.
+	Request Request
+
+	// ExpectedRequest defines the metadata the backend is expected to
+	// receive, if set.
+	ExpectedRequest *ExpectedRequest
+
+	// Response defines what response the test case should receive.
+	Response Response
+
+	Backend   string
+	Namespace string
+
+	// User Given TestCase name
+	TestCaseName string
+}
+
+// ExpectedRequest defines the metadata the backend is expected to receive.
+type ExpectedRequest struct {
+	Headers       map[string]string
+	AbsentHeaders []string
+}
+
+// Response defines the expected status of the response, which defaults to
+// OK.
+type Response struct {
+	Code codes.Code
+}
+
+// GetTestCaseName gets the user-defined test case name or generates one from
+// expected response to a given request.
+func (er *ExpectedResponse) GetTestCaseName(i int) string {
+	if er.TestCaseName != "" {
+		return er.TestCaseName
+	}
+
+	reqStr := fmt.Sprintf("%d request to '%s%s'", i, er.Request.Authority, FullMethod(er.Request.Method))
+	if len(er.Request.Metadata) > 0 {
+		keys := make([]string, 0, len(er.Request.Metadata))
+		for key := range er.Request.Metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var pairs []string
+		for _, key := range keys {
+			pairs = append(pairs, key+":"+er.Request.Metadata[key])
+		}
+		reqStr += fmt.Sprintf(" with metadata '%s'", strings.Join(pairs, ","))
+	}
+
+	if er.Backend != "" {
+		return fmt.Sprintf("%s should go to %s", reqStr, er.Backend)
+	}
+	return fmt.Sprintf("%s should receive a %s", reqStr, er.Response.Code)
+}
+
+// MakeRequestAndExpectEventuallyConsistentResponse makes a request with the
+// given parameters, understanding that the request may fail for some amount of
+// time.
+//
+// Once the request succeeds consistently with the expected response, the
+// function returns.
+func MakeRequestAndExpectEventuallyConsistentResponse(t *testing.T, r RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedResponse) {
+	t.Helper()
+
+	req := expected.Request
+	req.Address = gwAddr
+	t.Logf("Making gRPC request to %s%s", gwAddr, FullMethod(req.Method))
+
+	http.AwaitConvergenceWithTimeoutConfig(t, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig, func(elapsed time.Duration) bool {
+		cRes, err := r.CaptureRoundTrip(req)
+		if err != nil {
+			t.Logf("Request failed, not ready yet: %v (after %v)", err, elapsed)
+			return false
+		}
+
+		if err := CompareResponse(req, cRes, expected); err != nil {
+			t.Logf("Response expectation failed for request: %v  not ready yet: %v (after %v)", req, err, elapsed)
+			return false
+		}
+
+		return true
+	})
+	t.Logf("Request passed")
+}
+
+// MakeRequestsAndExpectWeightedBackends waits for the request to succeed
+// consistently, then repeatedly sends batches of requests until the observed
+// distribution of responses across backends is within the configured
+// tolerance of the expected weights. The test fails immediately if any of the
+// requests reaches a backend with a weight of zero.
+func MakeRequestsAndExpectWeightedBackends(t *testing.T, r RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, req Request, backends http.WeightedBackends) {
+	t.Helper()
+
+	backends = backends.WithDefaults()
+	MakeRequestAndExpectEventuallyConsistentResponse(t, r, timeoutConfig, gwAddr, ExpectedResponse{Request: req})
+
+	req.Address = gwAddr
+	t.Logf("Making gRPC requests to %s%s, expecting responses weighted as %v", gwAddr, FullMethod(req.Method), backends.Weights)
+	http.AwaitConvergenceWithTimeoutConfig(t, 1, timeoutConfig, func(elapsed time.Duration) bool {
+		counts := map[string]int{}
+		for i := 0; i < backends.Requests; i++ {
+			cRes, err := r.CaptureRoundTrip(req)
+			if err != nil {
+				t.Logf("Request failed, not ready yet: %v (after %v)", err, elapsed)
+				return false
+			}
+			if cRes.Code != codes.OK {
+				t.Logf("Response expectation failed for request: %v  expected status code OK, got %s, not ready yet (after %v)", req, cRes.Code, elapsed)
+				return false
+			}
+			backend := http.BackendForPod(cRes.Response.GetAssertions().GetContext().GetPod(), backends.Weights)
+			http.BackendMustNotHaveZeroWeight(t, backend, backends.Weights)
+			counts[backend]++
+		}
+
+		if err := http.CompareDistribution(counts, backends.Weights, backends.Requests, backends.Tolerance); err != nil {
+			t.Logf("Traffic distribution not as expected: %v (after %v)", err, elapsed)
+			return false
+		}
+		t.Logf("Observed traffic distribution %v over %d requests", counts, backends.Requests)
+		return true
+	})
+	t.Logf("Requests passed")
+}
+
+// CompareResponse returns an error if the captured response does not match
+// the expected response to the request.
+func CompareResponse(req Request, cRes *CapturedResponse, expected ExpectedResponse) error {
+	if expected.Response.Code != cRes.Code {
+		return fmt.Errorf("expected status code to be %s, got %s", expected.Response.Code, cRes.Code)
+	}
+	if cRes.Code != codes.OK {
+		return nil
+	}
+
+	assertions := cRes.Response.GetAssertions()
+	if method := FullMethod(req.Method); method != assertions.GetFullyQualifiedMethod() {
+		return fmt.Errorf("expected method to be %s, got %s", method, assertions.GetFullyQualifiedMethod())
+	}
+
+	if expected.Namespace != "" && expected.Namespace != assertions.GetContext().GetNamespace() {
+		return fmt.Errorf("expected namespace to be %s, got %s", expected.Namespace, assertions.GetContext().GetNamespace())
+	}
+
+	if !strings.HasPrefix(assertions.GetContext().GetPod(), expected.Backend) {
+		return fmt.Errorf("expected pod name to start with %s, got %s", expected.Backend, assertions.GetContext().GetPod())
+	}
+
+	if expected.ExpectedRequest != nil {
+		received := map[string][]string{}
+		for _, header := range assertions.GetHeaders() {
+			name := strings.ToLower(header.GetKey())
+			received[name] = append(received[name], header.GetValue())
+		}
+
+		for name, value := range expected.ExpectedRequest.Headers {
+			values, ok := received[strings.ToLower(name)]
+			if !ok {
+				return fmt.Errorf("expected %s header to be set, actual headers: %v", name, received)
+			}
+			if !sets.New(values...).Has(value) {
+				return fmt.Errorf("expected %s header to be set to %s, got %v", name, value, values)
+			}
+		}
+
+		for _, name := range expected.ExpectedRequest.AbsentHeaders {
+			if values, ok := received[strings.ToLower(name)]; ok {
+				return fmt.Errorf("expected %s header to not be set, got %v", name, values)
+			}
+		}
+	}
+
+	return nil
+}
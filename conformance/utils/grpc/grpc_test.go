@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	pb "sigs.k8s.io/gateway-api/conformance/echo-basic/grpcecho"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// echoServer mimics the gRPC echo backend of the base manifests.
+type echoServer struct {
+	pb.UnimplementedGrpcEchoServer
+}
+
+func (echoServer) respond(ctx context.Context, method string) (*pb.EchoResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	assertions := &pb.Assertions{
+		FullyQualifiedMethod: FullMethod(method),
+		Context:              &pb.Context{Namespace: "gateway-conformance-infra", Pod: "grpc-infra-backend-v1-abc"},
+	}
+	for key, values := range md {
+		for _, value := range values {
+			assertions.Headers = append(assertions.Headers, &pb.Header{Key: key, Value: value})
+		}
+	}
+	if authority := md.Get(":authority"); len(authority) > 0 {
+		assertions.Authority = authority[0]
+	}
+	return &pb.EchoResponse{Assertions: assertions}, nil
+}
+
+func (s echoServer) Echo(ctx context.Context, _ *pb.EchoRequest) (*pb.EchoResponse, error) {
+	return s.respond(ctx, EchoMethod)
+}
+
+func (s echoServer) EchoTwo(ctx context.Context, _ *pb.EchoRequest) (*pb.EchoResponse, error) {
+	return s.respond(ctx, EchoTwoMethod)
+}
+
+func startEchoServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	pb.RegisterGrpcEchoServer(server, echoServer{})
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func TestCaptureRoundTrip(t *testing.T) {
+	addr := startEchoServer(t)
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+
+	tests := []struct {
+		name     string
+		request  Request
+		expected ExpectedResponse
+	}{{
+		name:    "default method",
+		request: Request{Address: addr},
+		expected: ExpectedResponse{
+			Backend:   "grpc-infra-backend-v1",
+			Namespace: "gateway-conformance-infra",
+		},
+	}, {
+		name:    "metadata",
+		request: Request{Address: addr, Method: EchoTwoMethod, Authority: "example.com", Metadata: map[string]string{"Version": "two"}},
+		expected: ExpectedResponse{
+			Backend: "grpc-infra-backend-v1",
+			ExpectedRequest: &ExpectedRequest{
+				Headers:       map[string]string{"version": "two"},
+				AbsentHeaders: []string{"x-missing"},
+			},
+		},
+	}, {
+		name:    "unimplemented method",
+		request: Request{Address: addr, Method: EchoThreeMethod},
+		expected: ExpectedResponse{
+			Response: Response{Code: codes.Unimplemented},
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cRes, err := rt.CaptureRoundTrip(tc.request)
+			require.NoError(t, err)
+			require.NoError(t, CompareResponse(tc.request, cRes, tc.expected))
+		})
+	}
+}
+
+func TestCompareResponse(t *testing.T) {
+	res := &CapturedResponse{
+		Code: codes.OK,
+		Response: &pb.EchoResponse{Assertions: &pb.Assertions{
+			FullyQualifiedMethod: FullMethod(EchoMethod),
+			Headers:              []*pb.Header{{Key: "x-header", Value: "value"}},
+			Context:              &pb.Context{Namespace: "gateway-conformance-infra", Pod: "grpc-infra-backend-v2-abc"},
+		}},
+	}
+
+	tests := []struct {
+		name     string
+		request  Request
+		expected ExpectedResponse
+	}{{
+		name:     "wrong code",
+		expected: ExpectedResponse{Response: Response{Code: codes.NotFound}},
+	}, {
+		name:     "wrong method",
+		request:  Request{Method: EchoTwoMethod},
+		expected: ExpectedResponse{Backend: "grpc-infra-backend-v2"},
+	}, {
+		name:     "wrong backend",
+		expected: ExpectedResponse{Backend: "grpc-infra-backend-v1"},
+	}, {
+		name:     "wrong namespace",
+		expected: ExpectedResponse{Backend: "grpc-infra-backend-v2", Namespace: "gateway-conformance-app-backend"},
+	}, {
+		name:     "missing header",
+		expected: ExpectedResponse{Backend: "grpc-infra-backend-v2", ExpectedRequest: &ExpectedRequest{Headers: map[string]string{"x-other": "value"}}},
+	}, {
+		name:     "unexpected header",
+		expected: ExpectedResponse{Backend: "grpc-infra-backend-v2", ExpectedRequest: &ExpectedRequest{AbsentHeaders: []string{"X-Header"}}},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, CompareResponse(tc.request, res, tc.expected))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// AwaitConvergenceWithTimeoutConfig runs the given function until it returns 'true' `threshold`
+// times in a row, within the MaxTimeToConsistency of the TimeoutConfig. Failed attempts are
+// followed by a delay from its ConvergenceBackoff, and the metrics of the convergence are added
+// to its Convergences recorder, if any.
+func AwaitConvergenceWithTimeoutConfig(t *testing.T, threshold int, timeoutConfig config.TimeoutConfig, fn func(elapsed time.Duration) bool) {
+	metrics := awaitConvergence(t, threshold, timeoutConfig.MaxTimeToConsistency, timeoutConfig.ConvergenceBackoff, fn)
+	if timeoutConfig.Convergences != nil {
+		timeoutConfig.Convergences.Record(metrics)
+	}
+}
+
+// AwaitConvergenceWithBackoff runs the given function until it returns 'true' `threshold` times
+// in a row. Failed attempts are followed by a delay from the given backoff, which grows with the
+// number of failed attempts in a row; successful attempts have no delay. The time to the first
+// success and to consistency are logged.
+func AwaitConvergenceWithBackoff(t *testing.T, threshold int, maxTimeToConsistency time.Duration, backoff config.Backoff, fn func(elapsed time.Duration) bool) {
+	awaitConvergence(t, threshold, maxTimeToConsistency, backoff, fn)
+}
+
+func awaitConvergence(t *testing.T, threshold int, maxTimeToConsistency time.Duration, backoff config.Backoff, fn func(elapsed time.Duration) bool) config.ConvergenceMetrics {
+	successes := 0
+	failures := 0
+	attempts := 0
+	var timeToFirstSuccess time.Duration
+	start := time.Now()
+	to := time.After(maxTimeToConsistency)
+	for {
+		select {
+		case <-to:
+			t.Fatalf("timeout while waiting after %d attempts", attempts)
+		default:
+		}
+
+		completed := fn(time.Since(start))
+		attempts++
+		if completed {
+			if timeToFirstSuccess == 0 {
+				timeToFirstSuccess = time.Since(start)
+			}
+			failures = 0
+			successes++
+			if successes >= threshold {
+				metrics := config.ConvergenceMetrics{
+					TestName:           t.Name(),
+					TimeToFirstSuccess: timeToFirstSuccess,
+					TimeToConsistency:  time.Since(start),
+					Attempts:           attempts,
+				}
+				t.Logf("Converged after %d attempts, first success after %v, consistent after %v", attempts, metrics.TimeToFirstSuccess, metrics.TimeToConsistency)
+				return metrics
+			}
+			// Skip delay if we have a success
+			continue
+		}
+
+		successes = 0
+		failures++
+		select {
+		// Capture the overall timeout
+		case <-to:
+			t.Fatalf("timeout while waiting after %d attempts, %d/%d successes", attempts, successes, threshold)
+			// And the per-try delay
+		case <-time.After(backoff.Delay(failures, rand.Float64())):
+		}
+	}
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+func TestAwaitConvergenceWithTimeoutConfig(t *testing.T) {
+	timeoutConfig := config.TimeoutConfig{
+		MaxTimeToConsistency: time.Minute,
+		ConvergenceBackoff:   config.Backoff{Initial: 10 * time.Millisecond, Max: 20 * time.Millisecond, Factor: 2, Jitter: 0.1},
+		Convergences:         &config.ConvergenceRecorder{},
+	}
+
+	t.Run("flaky", func(t *testing.T) {
+		results := []bool{false, false, true, false, true, true, true}
+		attempt := 0
+		AwaitConvergenceWithTimeoutConfig(t, 3, timeoutConfig, func(time.Duration) bool {
+			attempt++
+			return results[attempt-1]
+		})
+		require.Equal(t, len(results), attempt)
+	})
+
+	metrics := timeoutConfig.Convergences.Take(t.Name())
+	require.Len(t, metrics, 1)
+	require.Equal(t, t.Name()+"/flaky", metrics[0].TestName)
+	require.Equal(t, 7, metrics[0].Attempts)
+	// The two failed attempts are followed by delays of 10ms and 20ms.
+	require.GreaterOrEqual(t, metrics[0].TimeToFirstSuccess, 30*time.Millisecond)
+	require.Greater(t, metrics[0].TimeToConsistency, metrics[0].TimeToFirstSuccess)
+
+	require.Empty(t, timeoutConfig.Convergences.Take(t.Name()), "metrics must only be taken once")
+}
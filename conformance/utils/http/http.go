@@ -17,12 +17,15 @@ limitations under the License.
 package http
 
 import (
+	"crypto/x509"
 	"fmt"
 	"net/url"
 	"strings"
 	"testing"
 	"time"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
 	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
 )
@@ -80,6 +83,11 @@ type Response struct {
 	StatusCode    int
 	Headers       map[string]string
 	AbsentHeaders []string
+
+	// CertificateDNSNames are the DNS Subject Alternative Names the
+	// certificate served for the request is expected to have. They are only
+	// checked when set.
+	CertificateDNSNames []string
 }
 
 // MakeRequestAndExpectEventuallyConsistentResponse makes a request with the given parameters,
@@ -92,7 +100,7 @@ func MakeRequestAndExpectEventuallyConsistentResponse(t *testing.T, r roundtripp
 
 	req := MakeRequest(t, &expected, gwAddr, "HTTP", "http")
 
-	WaitForConsistentResponse(t, r, req, expected, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig.MaxTimeToConsistency)
+	waitForConsistentResponse(t, r, req, expected, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig)
 }
 
 func MakeRequest(t *testing.T, expected *ExpectedResponse, gwAddr, protocol, scheme string) roundtripper.Request {
@@ -137,45 +145,21 @@ func MakeRequest(t *testing.T, expected *ExpectedResponse, gwAddr, protocol, sch
 // AwaitConvergence runs the given function until it returns 'true' `threshold` times in a row.
 // Each failed attempt has a 1s delay; successful attempts have no delay.
 func AwaitConvergence(t *testing.T, threshold int, maxTimeToConsistency time.Duration, fn func(elapsed time.Duration) bool) {
-	successes := 0
-	attempts := 0
-	start := time.Now()
-	to := time.After(maxTimeToConsistency)
-	delay := time.Second
-	for {
-		select {
-		case <-to:
-			t.Fatalf("timeout while waiting after %d attempts", attempts)
-		default:
-		}
-
-		completed := fn(time.Now().Sub(start))
-		attempts++
-		if completed {
-			successes++
-			if successes >= threshold {
-				return
-			}
-			// Skip delay if we have a success
-			continue
-		}
-
-		successes = 0
-		select {
-		// Capture the overall timeout
-		case <-to:
-			t.Fatalf("timeout while waiting after %d attempts, %d/%d successes", attempts, successes, threshold)
-			// And the per-try delay
-		case <-time.After(delay):
-		}
-	}
+	AwaitConvergenceWithBackoff(t, threshold, maxTimeToConsistency, config.ConstantBackoff(time.Second), fn)
 }
 
 // WaitForConsistentResponse repeats the provided request until it completes with a response having
 // the expected response consistently. The provided threshold determines how many times in
-// a row this must occur to be considered "consistent".
+// a row this must occur to be considered "consistent". Each failed attempt has a 1s delay.
 func WaitForConsistentResponse(t *testing.T, r roundtripper.RoundTripper, req roundtripper.Request, expected ExpectedResponse, threshold int, maxTimeToConsistency time.Duration) {
-	AwaitConvergence(t, threshold, maxTimeToConsistency, func(elapsed time.Duration) bool {
+	waitForConsistentResponse(t, r, req, expected, threshold, config.TimeoutConfig{
+		MaxTimeToConsistency: maxTimeToConsistency,
+		ConvergenceBackoff:   config.ConstantBackoff(time.Second),
+	})
+}
+
+func waitForConsistentResponse(t *testing.T, r roundtripper.RoundTripper, req roundtripper.Request, expected ExpectedResponse, threshold int, timeoutConfig config.TimeoutConfig) {
+	AwaitConvergenceWithTimeoutConfig(t, threshold, timeoutConfig, func(elapsed time.Duration) bool {
 		cReq, cRes, err := r.CaptureRoundTrip(req)
 		if err != nil {
 			t.Logf("Request failed, not ready yet: %v (after %v)", err.Error(), elapsed)
@@ -196,6 +180,9 @@ func CompareRequest(req *roundtripper.Request, cReq *roundtripper.CapturedReques
 	if expected.Response.StatusCode != cRes.StatusCode {
 		return fmt.Errorf("expected status code to be %d, got %d", expected.Response.StatusCode, cRes.StatusCode)
 	}
+	if err := compareCertificateDNSNames(expected.Response.CertificateDNSNames, cRes.PeerCertificates); err != nil {
+		return err
+	}
 	if cRes.StatusCode == 200 {
 		// The request expected to arrive at the backend is
 		// the same as the request made, unless otherwise
@@ -314,6 +301,23 @@ func CompareRequest(req *roundtripper.Request, cReq *roundtripper.CapturedReques
 	return nil
 }
 
+// compareCertificateDNSNames checks that the leaf certificate has exactly the
+// expected DNS Subject Alternative Names, in any order.
+func compareCertificateDNSNames(expected []string, peerCertificates []*x509.Certificate) error {
+	if len(expected) == 0 {
+		return nil
+	}
+	if len(peerCertificates) == 0 {
+		return fmt.Errorf("expected a certificate with DNS names %v, but no certificate was captured", expected)
+	}
+
+	actual := peerCertificates[0].DNSNames
+	if !sets.New(expected...).Equal(sets.New(actual...)) {
+		return fmt.Errorf("expected certificate DNS names to be %v, got %v", expected, actual)
+	}
+	return nil
+}
+
 // GetTestCaseName gets the user-defined test case name or generates one from expected response to a given request.
 func (er *ExpectedResponse) GetTestCaseName(i int) string {
 
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+func TestCompareRequestCertificateDNSNames(t *testing.T) {
+	wildcard := &x509.Certificate{DNSNames: []string{"*.example.com", "example.com"}}
+
+	testCases := []struct {
+		name             string
+		expected         []string
+		peerCertificates []*x509.Certificate
+		wantErr          string
+	}{{
+		name:             "no expectation",
+		peerCertificates: nil,
+	}, {
+		name:             "matching DNS names in any order",
+		expected:         []string{"example.com", "*.example.com"},
+		peerCertificates: []*x509.Certificate{wildcard},
+	}, {
+		name:             "different DNS names",
+		expected:         []string{"foo.example.com"},
+		peerCertificates: []*x509.Certificate{wildcard},
+		wantErr:          "expected certificate DNS names to be [foo.example.com], got [*.example.com example.com]",
+	}, {
+		name:     "no certificate captured",
+		expected: []string{"foo.example.com"},
+		wantErr:  "expected a certificate with DNS names [foo.example.com], but no certificate was captured",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &roundtripper.Request{}
+			cRes := &roundtripper.CapturedResponse{StatusCode: 404, PeerCertificates: tc.peerCertificates}
+			expected := ExpectedResponse{Response: Response{StatusCode: 404, CertificateDNSNames: tc.expected}}
+
+			err := CompareRequest(req, &roundtripper.CapturedRequest{}, cRes, expected)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientset "k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// ExpectMirroredRequest waits until the expected request shows up in the logs
+// written since the given time by the Pods of the mirror backend. Mirror
+// backends log the path of every request they receive, and echo-basic also
+// logs its headers. The request must have the path of the expected request
+// and, when the backend logs them, its headers, with multiple values of a
+// header given as a comma-separated value.
+//
+// The Pods of the mirror backend are found with the "app" label, which is set
+// to the backend name in the base manifests.
+func ExpectMirroredRequest(t *testing.T, cs clientset.Interface, timeoutConfig config.TimeoutConfig, since time.Time, ns, mirrorBackend string, expected Request) {
+	t.Helper()
+
+	if cs == nil {
+		t.Fatalf("a Kubernetes clientset is required to read the logs of %s/%s", ns, mirrorBackend)
+	}
+
+	t.Logf("Searching for a mirrored request to %s with headers %v in the logs of %s/%s", expected.Path, expected.Headers, ns, mirrorBackend)
+	sinceTime := metav1.NewTime(since)
+	waitErr := wait.PollImmediate(time.Second, timeoutConfig.MaxTimeToConsistency, func() (bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.GetTimeout)
+		defer cancel()
+
+		pods, err := cs.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: "app=" + mirrorBackend})
+		if err != nil {
+			t.Logf("Error listing Pods of %s/%s: %v", ns, mirrorBackend, err)
+			return false, nil
+		}
+		for _, pod := range pods.Items {
+			logs, err := cs.CoreV1().Pods(ns).GetLogs(pod.Name, &v1.PodLogOptions{SinceTime: &sinceTime}).DoRaw(ctx)
+			if err != nil {
+				t.Logf("Error reading logs of %s/%s: %v", ns, pod.Name, err)
+				continue
+			}
+			headersLogged, err := mirroredRequestLogged(logs, expected)
+			if err != nil {
+				t.Logf("Mirrored request not found in the logs of %s/%s: %v", ns, pod.Name, err)
+				continue
+			}
+			if !headersLogged && len(expected.Headers) > 0 {
+				t.Logf("%s/%s does not log request headers, only the path of the mirrored request is verified", ns, pod.Name)
+			}
+			t.Logf("Found a mirrored request to %s in the logs of %s/%s", expected.Path, ns, pod.Name)
+			return true, nil
+		}
+		return false, nil
+	})
+	if waitErr != nil {
+		t.Fatalf("error waiting for a mirrored request to %s in the logs of %s/%s: %v", expected.Path, ns, mirrorBackend, waitErr)
+	}
+}
+
+// mirroredRequestLogged returns nil if the logs contain a request for the path
+// of the expected request. Requests logged with their headers, as done by
+// echo-basic, must also have the headers of the expected request, and the
+// returned bool reports whether the headers were verified this way.
+func mirroredRequestLogged(logs []byte, expected Request) (bool, error) {
+	re := regexp.MustCompile(fmt.Sprintf(`Echoing back request made to %s to client \([^)]*\)(?: with headers (.*))?`, regexp.QuoteMeta(expected.Path)))
+	matches := re.FindAllSubmatch(logs, -1)
+	if len(matches) == 0 {
+		return false, fmt.Errorf("no request made to %s", expected.Path)
+	}
+
+	var lastErr error
+	for _, match := range matches {
+		if len(match[1]) == 0 {
+			return false, nil
+		}
+		headers := map[string][]string{}
+		if err := json.Unmarshal(match[1], &headers); err != nil {
+			lastErr = fmt.Errorf("error decoding the headers of a request made to %s: %w", expected.Path, err)
+			continue
+		}
+		if lastErr = compareMirroredHeaders(expected.Headers, headers); lastErr == nil {
+			return true, nil
+		}
+	}
+	return false, lastErr
+}
+
+// compareMirroredHeaders checks that each expected header was received with
+// the expected value.
+func compareMirroredHeaders(expected map[string]string, received map[string][]string) error {
+	for name, value := range expected {
+		values, ok := received[http.CanonicalHeaderKey(name)]
+		if !ok {
+			return fmt.Errorf("expected %s header to be set, actual headers: %v", name, received)
+		}
+		if actual := strings.Join(values, ","); actual != value {
+			return fmt.Errorf("expected %s header to be set to %s, got %s", name, value, actual)
+		}
+	}
+	return nil
+}
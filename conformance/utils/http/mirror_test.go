@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMirroredRequestLogged(t *testing.T) {
+	logs := []byte(`2023/03/01 10:00:00 Echoing back request made to /other to client (10.0.0.1:1234) with headers {"User-Agent":["Go-http-client/1.1"]}
+2023/03/01 10:00:01 Echoing back request made to /mirror to client (10.0.0.1:1235) with headers {"User-Agent":["Go-http-client/1.1"],"X-Mirror":["a","b"]}
+2023/03/01 10:00:02 Echoing back request made to /mirror to client (10.0.0.1:1236) with headers {"X-Mirror":["c"]}
+`)
+
+	tests := []struct {
+		name     string
+		expected Request
+		found    bool
+	}{
+		{name: "path", expected: Request{Path: "/mirror"}, found: true},
+		{name: "other path", expected: Request{Path: "/other"}, found: true},
+		{name: "longer path", expected: Request{Path: "/mirror/path"}},
+		{name: "path with regexp characters", expected: Request{Path: "/mir.or"}},
+		{name: "headers", expected: Request{Path: "/mirror", Headers: map[string]string{"x-mirror": "a,b", "User-Agent": "Go-http-client/1.1"}}, found: true},
+		{name: "headers of a later request", expected: Request{Path: "/mirror", Headers: map[string]string{"X-Mirror": "c"}}, found: true},
+		{name: "headers of another path", expected: Request{Path: "/other", Headers: map[string]string{"X-Mirror": "c"}}},
+		{name: "missing header", expected: Request{Path: "/mirror", Headers: map[string]string{"X-Missing": "a"}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			headersLogged, err := mirroredRequestLogged(logs, tc.expected)
+			if tc.found {
+				require.NoError(t, err)
+				require.True(t, headersLogged)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	_, err := mirroredRequestLogged(nil, Request{Path: "/mirror"})
+	require.Error(t, err)
+}
+
+func TestMirroredRequestLoggedWithoutHeaders(t *testing.T) {
+	// echoserver only logs the path of the requests it receives.
+	logs := []byte(`2023/03/01 10:00:00 Echoing back request made to /mirror to client (10.0.0.1:1234)
+`)
+
+	headersLogged, err := mirroredRequestLogged(logs, Request{Path: "/mirror", Headers: map[string]string{"X-Mirror": "a"}})
+	require.NoError(t, err)
+	require.False(t, headersLogged)
+
+	_, err = mirroredRequestLogged(logs, Request{Path: "/other"})
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+// MakeWebSocketRequestAndExpectEventuallyConsistentEcho performs a WebSocket
+// handshake with the Gateway address for the given host and path, and sends
+// the given messages, understanding that the exchange may fail for some amount
+// of time. The function returns once every message is consistently echoed
+// back, as done by the echo-basic backend on its /ws path.
+func MakeWebSocketRequestAndExpectEventuallyConsistentEcho(t *testing.T, r roundtripper.RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr, host, path string, messages []string) {
+	t.Helper()
+
+	urt, ok := r.(roundtripper.UpgradeRoundTripper)
+	if !ok {
+		t.Fatalf("RoundTripper %T does not implement roundtripper.UpgradeRoundTripper", r)
+	}
+
+	req := roundtripper.UpgradeRequest{
+		URL:      url.URL{Scheme: "ws", Host: gwAddr, Path: path},
+		Host:     host,
+		Messages: messages,
+	}
+	t.Logf("Making WebSocket request to %s", req.URL.String())
+
+	AwaitConvergenceWithTimeoutConfig(t, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig, func(elapsed time.Duration) bool {
+		cRes, err := urt.CaptureUpgradeRoundTrip(req)
+		if err != nil {
+			t.Logf("WebSocket exchange failed, not ready yet: %v (after %v)", err, elapsed)
+			return false
+		}
+		if err := compareEchoedMessages(messages, cRes.Messages); err != nil {
+			t.Logf("WebSocket exchange expectation failed: %v, not ready yet (after %v)", err, elapsed)
+			return false
+		}
+		return true
+	})
+	t.Logf("WebSocket request passed")
+}
+
+// compareEchoedMessages checks that each of the sent messages was echoed back.
+func compareEchoedMessages(sent, received []string) error {
+	if len(sent) != len(received) {
+		return fmt.Errorf("expected %d messages, got %d", len(sent), len(received))
+	}
+	for i := range sent {
+		if sent[i] != received[i] {
+			return fmt.Errorf("expected message %d to be %q, got %q", i, sent[i], received[i])
+		}
+	}
+	return nil
+}
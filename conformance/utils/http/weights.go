@@ -0,0 +1,210 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+const (
+	// DefaultWeightedRequests is the number of requests sent to sample the
+	// traffic distribution when WeightedBackends.Requests is not set.
+	DefaultWeightedRequests = 500
+
+	// DefaultWeightTolerance is the maximum allowed difference between the
+	// observed and expected share of traffic for a backend when
+	// WeightedBackends.Tolerance is not set.
+	DefaultWeightTolerance = 0.1
+)
+
+// WeightedBackends describes how traffic is expected to be split between
+// backends.
+type WeightedBackends struct {
+	// Weights maps backend names to their configured weights. Backends with
+	// a weight of zero must not receive any requests.
+	Weights map[string]int32
+
+	// Requests is the number of requests sent to sample the distribution.
+	// Defaults to DefaultWeightedRequests.
+	Requests int
+
+	// Tolerance is the maximum allowed absolute difference between the
+	// observed and expected share of traffic for each backend, as a fraction
+	// between 0 and 1. Defaults to DefaultWeightTolerance.
+	Tolerance float64
+}
+
+// WithDefaults returns the WeightedBackends with the default Requests and
+// Tolerance set if they are not.
+func (b WeightedBackends) WithDefaults() WeightedBackends {
+	if b.Requests == 0 {
+		b.Requests = DefaultWeightedRequests
+	}
+	if b.Tolerance == 0 {
+		b.Tolerance = DefaultWeightTolerance
+	}
+	return b
+}
+
+// MakeRequestsAndExpectWeightedBackends waits for the request to succeed
+// consistently, then repeatedly sends batches of requests until the observed
+// distribution of responses across backends is within the configured
+// tolerance of the expected weights. Each batch is made at once if the
+// RoundTripper is a BatchRoundTripper. The test fails immediately if any of the
+// requests reaches a backend with a weight of zero.
+//
+// The Backend field of the expected response is ignored, as the backend is
+// determined by the weights.
+func MakeRequestsAndExpectWeightedBackends(t *testing.T, r roundtripper.RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedResponse, backends WeightedBackends) {
+	t.Helper()
+
+	backends = backends.WithDefaults()
+	expected.Backend = ""
+	req := MakeRequest(t, &expected, gwAddr, "HTTP", "http")
+	waitForConsistentResponse(t, r, req, expected, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig)
+
+	AwaitConvergenceWithTimeoutConfig(t, 1, timeoutConfig, func(elapsed time.Duration) bool {
+		cReqs, cRess, err := captureRoundTrips(r, req, backends.Requests)
+		if err != nil {
+			t.Logf("Request failed, not ready yet: %v (after %v)", err.Error(), elapsed)
+			return false
+		}
+
+		counts := map[string]int{}
+		for i, cReq := range cReqs {
+			if err := CompareRequest(&req, cReq, cRess[i], expected); err != nil {
+				t.Logf("Response expectation failed for request: %v  not ready yet: %v (after %v)", req, err, elapsed)
+				return false
+			}
+			backend := BackendForPod(cReq.Pod, backends.Weights)
+			BackendMustNotHaveZeroWeight(t, backend, backends.Weights)
+			counts[backend]++
+		}
+
+		if err := CompareDistribution(counts, backends.Weights, backends.Requests, backends.Tolerance); err != nil {
+			t.Logf("Traffic distribution not as expected: %v (after %v)", err, elapsed)
+			return false
+		}
+		t.Logf("Observed traffic distribution %v over %d requests", counts, backends.Requests)
+		return true
+	})
+	t.Logf("Request passed")
+}
+
+// captureRoundTrips makes the request n times, with a single batch if the
+// RoundTripper supports it, and returns the captured requests and responses.
+func captureRoundTrips(r roundtripper.RoundTripper, req roundtripper.Request, n int) ([]*roundtripper.CapturedRequest, []*roundtripper.CapturedResponse, error) {
+	if br, ok := r.(roundtripper.BatchRoundTripper); ok {
+		return br.CaptureBatchRoundTrip(req, n)
+	}
+
+	cReqs := make([]*roundtripper.CapturedRequest, 0, n)
+	cRess := make([]*roundtripper.CapturedResponse, 0, n)
+	for i := 0; i < n; i++ {
+		cReq, cRes, err := r.CaptureRoundTrip(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		cReqs = append(cReqs, cReq)
+		cRess = append(cRess, cRes)
+	}
+	return cReqs, cRess, nil
+}
+
+// BackendForPod returns the name of the backend the pod belongs to, using the
+// longest backend name that prefixes the pod name. The pod name is returned
+// unchanged if it doesn't belong to any of the backends.
+func BackendForPod(pod string, weights map[string]int32) string {
+	backend := ""
+	for name := range weights {
+		if strings.HasPrefix(pod, name) && len(name) > len(backend) {
+			backend = name
+		}
+	}
+	if backend == "" {
+		return pod
+	}
+	return backend
+}
+
+// BackendMustNotHaveZeroWeight fails the test if the backend has a weight of
+// zero. It is meant to be called for every response once requests succeed
+// consistently, from which point backends with a weight of zero must never
+// receive a request, so that such a response is not retried as a mere
+// deviation from the expected distribution.
+func BackendMustNotHaveZeroWeight(t *testing.T, backend string, weights map[string]int32) {
+	t.Helper()
+
+	if weight, ok := weights[backend]; ok && weight == 0 {
+		t.Fatalf("backend %s has a weight of 0 but received a request", backend)
+	}
+}
+
+// CompareDistribution checks that the number of requests received by each
+// backend is within tolerance of its share of the total weight.
+func CompareDistribution(counts map[string]int, weights map[string]int32, total int, tolerance float64) error {
+	var totalWeight int32
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+	if totalWeight == 0 {
+		return fmt.Errorf("at least one backend must have a non-zero weight")
+	}
+
+	var errs []string
+	for _, backend := range sortedKeys(counts) {
+		if _, ok := weights[backend]; !ok {
+			errs = append(errs, fmt.Sprintf("unexpected backend %s received %d requests", backend, counts[backend]))
+		}
+	}
+	for _, backend := range sortedKeys(weights) {
+		weight, count := weights[backend], counts[backend]
+		if weight == 0 {
+			if count != 0 {
+				errs = append(errs, fmt.Sprintf("backend %s has a weight of 0 but received %d requests", backend, count))
+			}
+			continue
+		}
+		expectedShare := float64(weight) / float64(totalWeight)
+		actualShare := float64(count) / float64(total)
+		if math.Abs(expectedShare-actualShare) > tolerance {
+			errs = append(errs, fmt.Sprintf("backend %s received %.2f of requests, expected %.2f±%.2f", backend, actualShare, expectedShare, tolerance))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
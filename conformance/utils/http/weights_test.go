@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+func TestBackendForPod(t *testing.T) {
+	weights := map[string]int32{"backend": 1, "backend-v2": 1}
+
+	require.Equal(t, "backend", BackendForPod("backend-5d8c7b9f4-abcde", weights))
+	require.Equal(t, "backend-v2", BackendForPod("backend-v2-5d8c7b9f4-abcde", weights))
+	require.Equal(t, "other-5d8c7b9f4-abcde", BackendForPod("other-5d8c7b9f4-abcde", weights))
+}
+
+func TestCompareDistribution(t *testing.T) {
+	testCases := []struct {
+		name      string
+		counts    map[string]int
+		weights   map[string]int32
+		total     int
+		tolerance float64
+		wantErr   string
+	}{{
+		name:      "even split within tolerance",
+		counts:    map[string]int{"v1": 45, "v2": 55},
+		weights:   map[string]int32{"v1": 1, "v2": 1},
+		total:     100,
+		tolerance: 0.1,
+	}, {
+		name:      "uneven split within tolerance",
+		counts:    map[string]int{"v1": 72, "v2": 18, "v3": 10},
+		weights:   map[string]int32{"v1": 70, "v2": 20, "v3": 10},
+		total:     100,
+		tolerance: 0.05,
+	}, {
+		name:      "backend outside tolerance",
+		counts:    map[string]int{"v1": 80, "v2": 20},
+		weights:   map[string]int32{"v1": 1, "v2": 1},
+		total:     100,
+		tolerance: 0.1,
+		wantErr:   "backend v1 received 0.80 of requests, expected 0.50±0.10; backend v2 received 0.20 of requests, expected 0.50±0.10",
+	}, {
+		name:      "zero weight backend receives no requests",
+		counts:    map[string]int{"v1": 100},
+		weights:   map[string]int32{"v1": 1, "v2": 0},
+		total:     100,
+		tolerance: 0.1,
+	}, {
+		name:      "zero weight backend receives requests",
+		counts:    map[string]int{"v1": 99, "v2": 1},
+		weights:   map[string]int32{"v1": 1, "v2": 0},
+		total:     100,
+		tolerance: 0.1,
+		wantErr:   "backend v2 has a weight of 0 but received 1 requests",
+	}, {
+		name:      "unexpected backend",
+		counts:    map[string]int{"v1": 95, "other": 5},
+		weights:   map[string]int32{"v1": 1},
+		total:     100,
+		tolerance: 0.1,
+		wantErr:   "unexpected backend other received 5 requests",
+	}, {
+		name:      "all weights zero",
+		counts:    map[string]int{},
+		weights:   map[string]int32{"v1": 0},
+		total:     100,
+		tolerance: 0.1,
+		wantErr:   "at least one backend must have a non-zero weight",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := CompareDistribution(tc.counts, tc.weights, tc.total, tc.tolerance)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// weightedRoundTripper cycles through the given pods, responding from each
+// in turn.
+type weightedRoundTripper struct {
+	pods []string
+	next int
+}
+
+func (w *weightedRoundTripper) CaptureRoundTrip(req roundtripper.Request) (*roundtripper.CapturedRequest, *roundtripper.CapturedResponse, error) {
+	pod := w.pods[w.next%len(w.pods)]
+	w.next++
+	cReq := &roundtripper.CapturedRequest{
+		Path:      req.URL.Path,
+		Method:    req.Method,
+		Namespace: "ns",
+		Pod:       pod,
+	}
+	return cReq, &roundtripper.CapturedResponse{StatusCode: 200}, nil
+}
+
+func TestMakeRequestsAndExpectWeightedBackends(t *testing.T) {
+	timeoutConfig := config.TimeoutConfig{
+		MaxTimeToConsistency:         5 * time.Second,
+		RequiredConsecutiveSuccesses: 1,
+	}
+	r := &weightedRoundTripper{pods: []string{"v1-abc", "v1-def", "v1-ghi", "v2-abc"}}
+
+	MakeRequestsAndExpectWeightedBackends(t, r, timeoutConfig, "gateway", ExpectedResponse{
+		Request:   Request{Path: "/"},
+		Namespace: "ns",
+	}, WeightedBackends{
+		Weights:  map[string]int32{"v1": 3, "v2": 1, "v3": 0},
+		Requests: 100,
+	})
+}
+
+// batchRoundTripper is a weightedRoundTripper which also makes batches of
+// requests, and counts them.
+type batchRoundTripper struct {
+	weightedRoundTripper
+	batches int
+}
+
+func (b *batchRoundTripper) CaptureBatchRoundTrip(req roundtripper.Request, n int) ([]*roundtripper.CapturedRequest, []*roundtripper.CapturedResponse, error) {
+	b.batches++
+	cReqs := make([]*roundtripper.CapturedRequest, 0, n)
+	cRess := make([]*roundtripper.CapturedResponse, 0, n)
+	for i := 0; i < n; i++ {
+		cReq, cRes, _ := b.weightedRoundTripper.CaptureRoundTrip(req)
+		cReqs = append(cReqs, cReq)
+		cRess = append(cRess, cRes)
+	}
+	return cReqs, cRess, nil
+}
+
+func TestMakeRequestsAndExpectWeightedBackendsBatch(t *testing.T) {
+	timeoutConfig := config.TimeoutConfig{
+		MaxTimeToConsistency:         5 * time.Second,
+		RequiredConsecutiveSuccesses: 1,
+	}
+	r := &batchRoundTripper{weightedRoundTripper: weightedRoundTripper{pods: []string{"v1-abc", "v2-abc"}}}
+
+	MakeRequestsAndExpectWeightedBackends(t, r, timeoutConfig, "gateway", ExpectedResponse{
+		Request:   Request{Path: "/"},
+		Namespace: "ns",
+	}, WeightedBackends{
+		Weights:  map[string]int32{"v1": 1, "v2": 1},
+		Requests: 100,
+	})
+	require.Equal(t, 1, r.batches)
+	// Only the requests waiting for consistency are made one at a time.
+	require.Equal(t, 101, r.next)
+}
+
+func TestMakeRequestsAndExpectWeightedBackendsZeroWeight(t *testing.T) {
+	// The request reaching a backend with a weight of zero must fail this
+	// test, so the helper is run in a separate process.
+	if os.Getenv("GATEWAY_CONFORMANCE_ZERO_WEIGHT") == "1" {
+		timeoutConfig := config.TimeoutConfig{
+			MaxTimeToConsistency:         5 * time.Second,
+			RequiredConsecutiveSuccesses: 1,
+		}
+		// One request in a hundred reaches v2, which is within tolerance of
+		// the distribution, but must not be retried.
+		pods := make([]string, 100)
+		for i := range pods {
+			pods[i] = "v1-abc"
+		}
+		pods[50] = "v2-abc"
+		r := &weightedRoundTripper{pods: pods}
+
+		MakeRequestsAndExpectWeightedBackends(t, r, timeoutConfig, "gateway", ExpectedResponse{
+			Request:   Request{Path: "/"},
+			Namespace: "ns",
+		}, WeightedBackends{
+			Weights:  map[string]int32{"v1": 1, "v2": 0},
+			Requests: 100,
+		})
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestMakeRequestsAndExpectWeightedBackendsZeroWeight$")
+	cmd.Env = append(os.Environ(), "GATEWAY_CONFORMANCE_ZERO_WEIGHT=1")
+	out, err := cmd.CombinedOutput()
+	require.Error(t, err, "a request reaching a backend with a weight of zero must fail the test")
+	require.Contains(t, string(out), "backend v2 has a weight of 0 but received a request")
+}
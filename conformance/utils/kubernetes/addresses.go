@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// ValidateGatewayAddress checks that the value of a Gateway address is
+// well-formed for its type. Addresses with no type are IPAddresses. The values
+// of NamedAddresses and implementation-specific, domain-prefixed, types are
+// only required to be non-empty.
+func ValidateGatewayAddress(address v1beta1.GatewayAddress) error {
+	switch addressType := addressTypeOrDefault(address.Type); addressType {
+	case v1beta1.IPAddressType:
+		if net.ParseIP(address.Value) == nil {
+			return fmt.Errorf("%q is not a valid IPAddress", address.Value)
+		}
+	case v1beta1.HostnameAddressType:
+		if net.ParseIP(address.Value) != nil {
+			return fmt.Errorf("%q is an IP address, not a Hostname", address.Value)
+		}
+		if errs := validation.IsDNS1123Subdomain(address.Value); len(errs) > 0 {
+			return fmt.Errorf("%q is not a valid Hostname: %s", address.Value, strings.Join(errs, ", "))
+		}
+	default:
+		if address.Value == "" {
+			return fmt.Errorf("%s address must have a value", addressType)
+		}
+	}
+	return nil
+}
+
+// GatewayStatusMustHaveValidAddresses waits for the supplied Gateway to have
+// at least one address in status, and for its status to list all the expected
+// addresses, if any. All the addresses in status must be well-formed for their
+// type. It returns the addresses in status.
+func GatewayStatusMustHaveValidAddresses(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, gwNN types.NamespacedName, expected []v1beta1.GatewayAddress) []v1beta1.GatewayAddress {
+	t.Helper()
+
+	var addresses []v1beta1.GatewayAddress
+	waitErr := wait.PollImmediate(1*time.Second, timeoutConfig.GatewayMustHaveAddress, func() (bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.GetTimeout)
+		defer cancel()
+
+		gw := &v1beta1.Gateway{}
+		err := client.Get(ctx, gwNN, gw)
+		if err != nil {
+			return false, fmt.Errorf("error fetching Gateway: %w", err)
+		}
+
+		if err := ConditionsHaveLatestObservedGeneration(gw, gw.Status.Conditions); err != nil {
+			t.Log("Gateway", err)
+			return false, nil
+		}
+
+		addresses = gw.Status.Addresses
+		if len(addresses) == 0 {
+			t.Logf("Gateway %s has no addresses in status", gwNN)
+			return false, nil
+		}
+		for _, address := range expected {
+			if !hasGatewayAddress(addresses, address) {
+				t.Logf("Gateway %s status addresses %v don't include %s", gwNN, addresses, address.Value)
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	require.NoErrorf(t, waitErr, "error waiting for Gateway status to have addresses matching expectations")
+
+	for _, address := range addresses {
+		require.NoErrorf(t, ValidateGatewayAddress(address), "Gateway %s has a malformed address in status", gwNN)
+	}
+	return addresses
+}
+
+// hasGatewayAddress returns true if addresses include an address with the
+// same type and value as the supplied one.
+func hasGatewayAddress(addresses []v1beta1.GatewayAddress, address v1beta1.GatewayAddress) bool {
+	for _, a := range addresses {
+		if a.Value == address.Value && addressTypeOrDefault(a.Type) == addressTypeOrDefault(address.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+func addressTypeOrDefault(addressType *v1beta1.AddressType) v1beta1.AddressType {
+	if addressType == nil {
+		return v1beta1.IPAddressType
+	}
+	return *addressType
+}
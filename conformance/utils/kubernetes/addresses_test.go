@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func TestValidateGatewayAddress(t *testing.T) {
+	addressType := func(t v1beta1.AddressType) *v1beta1.AddressType { return &t }
+
+	testCases := []struct {
+		name    string
+		address v1beta1.GatewayAddress
+		wantErr bool
+	}{
+		{name: "IPv4 address", address: v1beta1.GatewayAddress{Type: addressType(v1beta1.IPAddressType), Value: "192.0.2.1"}},
+		{name: "IPv6 address", address: v1beta1.GatewayAddress{Type: addressType(v1beta1.IPAddressType), Value: "2001:db8::1"}},
+		{name: "IP address without type", address: v1beta1.GatewayAddress{Value: "192.0.2.1"}},
+		{name: "CIDR range", address: v1beta1.GatewayAddress{Type: addressType(v1beta1.IPAddressType), Value: "192.0.2.0/24"}, wantErr: true},
+		{name: "hostname as IP address", address: v1beta1.GatewayAddress{Value: "gateway.example.com"}, wantErr: true},
+		{name: "hostname", address: v1beta1.GatewayAddress{Type: addressType(v1beta1.HostnameAddressType), Value: "gateway.example.com"}},
+		{name: "IP address as hostname", address: v1beta1.GatewayAddress{Type: addressType(v1beta1.HostnameAddressType), Value: "192.0.2.1"}, wantErr: true},
+		{name: "invalid hostname", address: v1beta1.GatewayAddress{Type: addressType(v1beta1.HostnameAddressType), Value: "Gateway_Example.com"}, wantErr: true},
+		{name: "named address", address: v1beta1.GatewayAddress{Type: addressType(v1beta1.NamedAddressType), Value: "my-static-ip"}},
+		{name: "empty named address", address: v1beta1.GatewayAddress{Type: addressType(v1beta1.NamedAddressType)}, wantErr: true},
+		{name: "domain-prefixed type", address: v1beta1.GatewayAddress{Type: addressType("example.com/pool"), Value: "pool-1"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateGatewayAddress(tc.address)
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -22,62 +22,114 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
+	"os"
+	"path"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/gateway-api/conformance"
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
 )
 
+// FieldManager is the field manager resources are server-side applied with.
+const FieldManager = "gateway-api-conformance"
+
 // Applier prepares manifests depending on the available options and applies
 // them to the Kubernetes cluster.
 type Applier struct {
 	NamespaceLabels map[string]string
 	// ValidUniqueListenerPorts maps each listener port of each Gateway in the
-	// manifests to a valid, unique port. There must be as many
-	// ValidUniqueListenerPorts as there are listeners in the set of manifests.
-	// For example, given two Gateways, each with 2 listeners, there should be
+	// manifests to a valid, unique port. Listeners of the same Gateway that
+	// share a port keep sharing the port they are mapped to, so that e.g.
+	// listener conflicts and SNI-based listener selection are preserved.
+	// There must be as many ValidUniqueListenerPorts as there are distinct
+	// listener ports per Gateway in the set of manifests. For example, given
+	// two Gateways, each with 2 listeners on different ports, there should be
 	// four ValidUniqueListenerPorts.
 	// If empty or nil, ports are not modified.
 	ValidUniqueListenerPorts []v1beta1.PortNumber
 
+	// ListenerPorts, when set, hands out the ValidUniqueListenerPorts instead
+	// of assigning them in order for every manifest. Ports are reserved until
+	// the test that applied the manifest completes, so that manifests applied
+	// concurrently never get the same ports. In that case there must be enough
+	// ValidUniqueListenerPorts for all listener ports that exist at the same time.
+	ListenerPorts *ListenerPortPool
+
 	// GatewayClass will be used as the spec.gatewayClassName when applying Gateway resources
 	GatewayClass string
 
 	// ControllerName will be used as the spec.controllerName when applying GatewayClass resources
 	ControllerName string
+
+	// FS is the filesystem manifests are read from. Defaults to the embedded
+	// conformance manifests.
+	FS fs.FS
+
+	// ManifestFS are additional filesystems that manifests are read from
+	// when they are not found in FS, e.g. the manifests of
+	// implementation-specific tests.
+	ManifestFS []fs.FS
+
+	// TemplateValues, when set, are the values manifests are rendered with,
+	// as Go templates, before being applied. Manifests fetched from URLs are
+	// never rendered.
+	TemplateValues *ManifestTemplateValues
+
+	// FailOnDrift fails tests when the spec of the resources they applied
+	// was changed by another field manager than the tests themselves, e.g. by
+	// the implementation. Drift is only logged otherwise.
+	FailOnDrift bool
+
+	// BeforeDelete, when set, is called with the testing.T a resource was
+	// applied with by the cleanup function deleting it, before the resource
+	// is deleted, e.g. to collect the artifacts of failed tests while their
+	// resources still exist.
+	BeforeDelete func(t *testing.T)
 }
 
 // prepareGateway adjusts both listener ports and the gatewayClassName. It
 // returns an index pointing to the next valid listener port.
-func (a Applier) prepareGateway(t *testing.T, uObj *unstructured.Unstructured, portIndex int) int {
+func (a Applier) prepareGateway(t *testing.T, uObj *unstructured.Unstructured, ports []v1beta1.PortNumber, portIndex int) int {
 	err := unstructured.SetNestedField(uObj.Object, a.GatewayClass, "spec", "gatewayClassName")
 	require.NoErrorf(t, err, "error setting `spec.gatewayClassName` on %s Gateway resource", uObj.GetName())
 
-	if len(a.ValidUniqueListenerPorts) > 0 {
+	if len(ports) > 0 {
 		listeners, _, err := unstructured.NestedSlice(uObj.Object, "spec", "listeners")
 		require.NoErrorf(t, err, "error getting `spec.listeners` on %s Gateway resource", uObj.GetName())
 
+		// assigned maps the ports of the listeners in the manifest to the
+		// valid ports they were given, so that listeners sharing a port
+		// are given the same one.
+		assigned := map[int64]v1beta1.PortNumber{}
 		for i, uListener := range listeners {
-			require.Less(t, portIndex, len(a.ValidUniqueListenerPorts), "not enough unassigned valid ports for `spec.listeners[%d]` on %s Gateway resource", i, uObj.GetName())
-
 			listener, ok := uListener.(map[string]interface{})
 			require.Truef(t, ok, "unexpected type at `spec.listeners[%d]` on %s Gateway resource", i, uObj.GetName())
 
-			nextPort := a.ValidUniqueListenerPorts[portIndex]
+			port, _, _ := unstructured.NestedInt64(listener, "port")
+			nextPort, ok := assigned[port]
+			if !ok {
+				require.Less(t, portIndex, len(ports), "not enough unassigned valid ports for `spec.listeners[%d]` on %s Gateway resource", i, uObj.GetName())
+				nextPort = ports[portIndex]
+				assigned[port] = nextPort
+				portIndex++
+			}
+
 			err = unstructured.SetNestedField(listener, int64(nextPort), "port")
 			require.NoErrorf(t, err, "error setting `spec.listeners[%d].port` on %s Gateway resource", i, uObj.GetName())
 
-			portIndex++
 			listeners[i] = listener
 		}
 
@@ -88,6 +140,23 @@ func (a Applier) prepareGateway(t *testing.T, uObj *unstructured.Unstructured, p
 	return portIndex
 }
 
+// distinctListenerPorts returns the number of distinct ports of the listeners
+// of the provided Gateway, which is the number of valid ports it is mapped to.
+func distinctListenerPorts(uObj *unstructured.Unstructured) int {
+	listeners, _, _ := unstructured.NestedSlice(uObj.Object, "spec", "listeners")
+	ports := map[int64]bool{}
+	for _, uListener := range listeners {
+		listener, ok := uListener.(map[string]interface{})
+		if !ok {
+			// prepareGateway fails for such listeners.
+			return len(listeners)
+		}
+		port, _, _ := unstructured.NestedInt64(listener, "port")
+		ports[port] = true
+	}
+	return len(ports)
+}
+
 // prepareGatewayClass adjust the spec.controllerName on the resource
 func (a Applier) prepareGatewayClass(t *testing.T, uObj *unstructured.Unstructured) {
 	err := unstructured.SetNestedField(uObj.Object, a.ControllerName, "spec", "controllerName")
@@ -116,12 +185,9 @@ func prepareNamespace(t *testing.T, uObj *unstructured.Unstructured, namespaceLa
 
 // prepareResources uses the options from an Applier to tweak resources given by
 // a set of manifests.
-func (a Applier) prepareResources(t *testing.T, decoder *yaml.YAMLOrJSONDecoder) ([]unstructured.Unstructured, error) {
+func (a Applier) prepareResources(t *testing.T, decoder *yaml.YAMLOrJSONDecoder, timeoutConfig config.TimeoutConfig) ([]unstructured.Unstructured, error) {
 	var resources []unstructured.Unstructured
-
-	// portIndex is incremented for each listener we see. For a manifest file
-	// with 2 gateways, each with 2 listeners, it will be incremented 4 times.
-	portIndex := 0
+	portCount := 0
 
 	for {
 		uObj := unstructured.Unstructured{}
@@ -135,119 +201,190 @@ func (a Applier) prepareResources(t *testing.T, decoder *yaml.YAMLOrJSONDecoder)
 			continue
 		}
 
+		if uObj.GetKind() == "Gateway" {
+			portCount += distinctListenerPorts(&uObj)
+		}
+
+		resources = append(resources, uObj)
+	}
+
+	ports := a.ValidUniqueListenerPorts
+	if a.ListenerPorts != nil && portCount > 0 {
+		reserved, err := a.ListenerPorts.reserve(portCount, timeoutConfig.CreateTimeout)
+		require.NoError(t, err, "error reserving listener ports")
+		t.Cleanup(func() {
+			a.ListenerPorts.release(reserved)
+		})
+		ports = reserved
+	}
+
+	// portIndex is incremented for each distinct listener port of each
+	// gateway we see. For a manifest file with 2 gateways, each with 2
+	// listeners on different ports, it will be incremented 4 times.
+	portIndex := 0
+
+	for i := range resources {
+		uObj := &resources[i]
+
 		if uObj.GetKind() == "GatewayClass" {
-			a.prepareGatewayClass(t, &uObj)
+			a.prepareGatewayClass(t, uObj)
 		}
 		if uObj.GetKind() == "Gateway" {
-			portIndex = a.prepareGateway(t, &uObj, portIndex)
+			portIndex = a.prepareGateway(t, uObj, ports, portIndex)
 		}
 
 		if uObj.GetKind() == "Namespace" && uObj.GetObjectKind().GroupVersionKind().Group == "" {
-			prepareNamespace(t, &uObj, a.NamespaceLabels)
+			prepareNamespace(t, uObj, a.NamespaceLabels)
 		}
-
-		resources = append(resources, uObj)
 	}
 
 	return resources, nil
 }
 
+// MustApplyObjectsWithCleanup server-side applies the provided resources and
+// registers a cleanup function for them. Like with a Create, the resources are
+// updated with the objects returned by the API server, e.g. with their
+// creationTimestamp and resourceVersion.
 func (a Applier) MustApplyObjectsWithCleanup(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, resources []client.Object, cleanup bool) {
 	for _, resource := range resources {
-		resource := resource
-
-		ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.CreateTimeout)
-		defer cancel()
-
-		t.Logf("Creating %s %s", resource.GetName(), resource.GetObjectKind().GroupVersionKind().Kind)
-
-		err := c.Create(ctx, resource)
-		if err != nil {
-			if !apierrors.IsAlreadyExists(err) {
-				require.NoError(t, err, "error creating resource")
-			}
-		}
-
-		if cleanup {
-			t.Cleanup(func() {
-				ctx, cancel = context.WithTimeout(context.Background(), timeoutConfig.DeleteTimeout)
-				defer cancel()
-				t.Logf("Deleting %s %s", resource.GetName(), resource.GetObjectKind().GroupVersionKind().Kind)
-				err = c.Delete(ctx, resource)
-				require.NoErrorf(t, err, "error deleting resource")
-			})
-		}
+		uObj, err := toUnstructured(c, resource)
+		require.NoErrorf(t, err, "error converting resource")
+		live := a.mustApply(t, c, timeoutConfig, uObj, cleanup)
+		require.NoErrorf(t, fromUnstructured(live, resource), "error converting applied resource")
 	}
 }
 
-// MustApplyWithCleanup creates or updates Kubernetes resources defined with the
-// provided YAML file and registers a cleanup function for resources it created.
-// Note that this does not remove resources that already existed in the cluster.
+// MustApplyWithCleanup server-side applies Kubernetes resources defined with
+// the provided YAML file and registers a cleanup function for them. Note that
+// resources that already existed in the cluster are deleted as well.
 func (a Applier) MustApplyWithCleanup(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, location string, cleanup bool) {
-	data, err := getContentsFromPathOrURL(location, timeoutConfig)
+	data, err := getContents(location, timeoutConfig, a.manifestFS())
 	require.NoError(t, err)
 
+	if a.TemplateValues != nil && !isURL(location) {
+		data, err = renderManifest(location, data, a.TemplateValues)
+		require.NoError(t, err)
+	}
+
 	decoder := yaml.NewYAMLOrJSONDecoder(data, 4096)
 
-	resources, err := a.prepareResources(t, decoder)
+	resources, err := a.prepareResources(t, decoder, timeoutConfig)
 	if err != nil {
 		t.Logf("manifest: %s", data.String())
 		require.NoErrorf(t, err, "error parsing manifest")
 	}
 
 	for i := range resources {
-		uObj := &resources[i]
-
-		ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.CreateTimeout)
-		defer cancel()
+		a.mustApply(t, c, timeoutConfig, &resources[i], cleanup)
+	}
+}
 
-		namespacedName := types.NamespacedName{Namespace: uObj.GetNamespace(), Name: uObj.GetName()}
-		fetchedObj := uObj.DeepCopy()
-		err := c.Get(ctx, namespacedName, fetchedObj)
-		if err != nil {
-			if !apierrors.IsNotFound(err) {
-				require.NoErrorf(t, err, "error getting resource")
+// mustApply server-side applies the resource with the FieldManager, forcing
+// ownership of conflicting fields, and registers a cleanup function deleting
+// it. The spec of the resource is checked for drift at the end of the test,
+// whether or not it is cleaned up. The object returned by the API server is
+// returned.
+func (a Applier) mustApply(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, uObj *unstructured.Unstructured, cleanup bool) *unstructured.Unstructured {
+	// The applied configuration is kept aside, as Patch overwrites uObj with
+	// the object returned by the API server.
+	applied := uObj.DeepCopy()
+	applied.SetResourceVersion("")
+
+	t.Logf("Applying %s %s", uObj.GetName(), uObj.GetKind())
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.CreateTimeout)
+	defer cancel()
+	uObj = applied.DeepCopy()
+	err := c.Patch(ctx, uObj, client.Apply, client.FieldOwner(FieldManager), client.ForceOwnership)
+	require.NoErrorf(t, err, "error applying resource")
+
+	if cleanup {
+		t.Cleanup(func() {
+			ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.DeleteTimeout)
+			defer cancel()
+			if a.BeforeDelete != nil {
+				a.BeforeDelete(t)
 			}
-			t.Logf("Creating %s %s", uObj.GetName(), uObj.GetKind())
-			err = c.Create(ctx, uObj)
-			require.NoErrorf(t, err, "error creating resource")
-
-			if cleanup {
-				t.Cleanup(func() {
-					ctx, cancel = context.WithTimeout(context.Background(), timeoutConfig.DeleteTimeout)
-					defer cancel()
-					t.Logf("Deleting %s %s", uObj.GetName(), uObj.GetKind())
-					err = c.Delete(ctx, uObj)
-					if !apierrors.IsNotFound(err) {
-						require.NoErrorf(t, err, "error deleting resource")
-					}
-				})
+			t.Logf("Deleting %s %s", uObj.GetName(), uObj.GetKind())
+			err := c.Delete(ctx, uObj)
+			if !apierrors.IsNotFound(err) {
+				require.NoErrorf(t, err, "error deleting resource")
 			}
-			continue
-		}
+		})
+	}
 
-		uObj.SetResourceVersion(fetchedObj.GetResourceVersion())
-		t.Logf("Updating %s %s", uObj.GetName(), uObj.GetKind())
-		err = c.Update(ctx, uObj)
-
-		if cleanup {
-			t.Cleanup(func() {
-				ctx, cancel = context.WithTimeout(context.Background(), timeoutConfig.DeleteTimeout)
-				defer cancel()
-				t.Logf("Deleting %s %s", uObj.GetName(), uObj.GetKind())
-				err = c.Delete(ctx, uObj)
-				if !apierrors.IsNotFound(err) {
-					require.NoErrorf(t, err, "error deleting resource")
-				}
-			})
-		}
-		require.NoErrorf(t, err, "error updating resource")
+	// Cleanup functions are called in last added, first called order, so the
+	// drift check runs before the resource is deleted.
+	t.Cleanup(func() {
+		a.checkDrift(t, c, timeoutConfig, applied)
+	})
+
+	return uObj
+}
+
+// toUnstructured converts a typed resource to the unstructured configuration
+// applied for it. Its status is dropped, as it isn't part of the
+// configuration.
+func toUnstructured(c client.Client, resource client.Object) (*unstructured.Unstructured, error) {
+	if uObj, ok := resource.(*unstructured.Unstructured); ok {
+		return uObj.DeepCopy(), nil
 	}
+
+	gvk, err := apiutil.GVKForObject(resource, c.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	uObj := &unstructured.Unstructured{Object: content}
+	uObj.SetGroupVersionKind(gvk)
+	unstructured.RemoveNestedField(uObj.Object, "status")
+	unstructured.RemoveNestedField(uObj.Object, "metadata", "creationTimestamp")
+	return uObj, nil
 }
 
-// getContentsFromPathOrURL takes a string that can either be a local file
-// path or an https:// URL to YAML manifests and provides the contents.
-func getContentsFromPathOrURL(location string, timeoutConfig config.TimeoutConfig) (*bytes.Buffer, error) {
+// fromUnstructured updates the resource with the content of the unstructured
+// object, typically the object returned by the API server once the resource
+// was applied.
+func fromUnstructured(uObj *unstructured.Unstructured, resource client.Object) error {
+	if uResource, ok := resource.(*unstructured.Unstructured); ok {
+		uObj.DeepCopyInto(uResource)
+		return nil
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(uObj.Object, resource)
+}
+
+// manifestFS returns the filesystems manifests are read from, in order.
+func (a Applier) manifestFS() []fs.FS {
+	primary := a.FS
+	if primary == nil {
+		primary = conformance.Manifests
+	}
+	return append([]fs.FS{primary}, a.ManifestFS...)
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// isLocalPath returns true if the location is a path on the local filesystem,
+// rather than a path in the manifest filesystems, i.e. if it is absolute or
+// explicitly relative to the working directory.
+func isLocalPath(location string) bool {
+	return filepath.IsAbs(location) ||
+		strings.HasPrefix(location, "./") ||
+		strings.HasPrefix(location, "../")
+}
+
+// getContents takes a string that can either be a path or an https:// URL to
+// YAML manifests and provides the contents. Absolute paths, and paths
+// starting with "./" or "../", are read from the local filesystem. Other
+// paths are read from each of the provided filesystems in order, until found.
+// Paths to directories provide the contents of all the YAML files in the
+// directory, in lexical order.
+func getContents(location string, timeoutConfig config.TimeoutConfig, manifestFS []fs.FS) (*bytes.Buffer, error) {
 	if strings.HasPrefix(location, "http://") {
 		return nil, fmt.Errorf("data can't be retrieved from %s: http is not supported, use https", location)
 	} else if strings.HasPrefix(location, "https://") {
@@ -276,9 +413,65 @@ func getContentsFromPathOrURL(location string, timeoutConfig config.TimeoutConfi
 		}
 		return manifests, nil
 	}
-	b, err := conformance.Manifests.ReadFile(location)
+
+	if isLocalPath(location) {
+		clean := filepath.Clean(location)
+		info, err := os.Stat(clean)
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			return readManifests(os.DirFS(clean), ".")
+		}
+		return readManifests(os.DirFS(filepath.Dir(clean)), filepath.Base(clean))
+	}
+
+	err := fmt.Errorf("no filesystem to read %s from: %w", location, fs.ErrNotExist)
+	for i := 0; errors.Is(err, fs.ErrNotExist) && i < len(manifestFS); i++ {
+		var manifests *bytes.Buffer
+		manifests, err = readManifests(manifestFS[i], location)
+		if err == nil {
+			return manifests, nil
+		}
+	}
+	return nil, err
+}
+
+// readManifests reads the file at the given path, or all the YAML files of the
+// directory at the given path, from the filesystem.
+func readManifests(fsys fs.FS, name string) (*bytes.Buffer, error) {
+	info, err := fs.Stat(fsys, name)
 	if err != nil {
 		return nil, err
 	}
-	return bytes.NewBuffer(b), nil
+	if !info.IsDir() {
+		b, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.NewBuffer(b), nil
+	}
+
+	entries, err := fs.ReadDir(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	manifests := new(bytes.Buffer)
+	for _, entry := range entries {
+		if entry.IsDir() || !isYAMLFile(entry.Name()) {
+			continue
+		}
+		b, err := fs.ReadFile(fsys, path.Join(name, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		manifests.WriteString("\n---\n")
+		manifests.Write(b)
+	}
+	return manifests, nil
+}
+
+func isYAMLFile(name string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	return ext == ".yaml" || ext == ".yml"
 }
@@ -17,14 +17,25 @@ limitations under the License.
 package kubernetes
 
 import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
 	_ "sigs.k8s.io/gateway-api/conformance/utils/flags"
 )
 
@@ -282,6 +293,64 @@ spec:
 				},
 			},
 		}},
+	}, {
+		name: "listeners sharing a port with pooled listener ports",
+		applier: Applier{
+			ListenerPorts: NewListenerPortPool([]v1beta1.PortNumber{8000, 8001}),
+		},
+		given: `
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind:       Gateway
+metadata:
+  name: test
+spec:
+  gatewayClassName: {GATEWAY_CLASS_NAME}
+  listeners:
+    - name: http-first
+      port: 80
+      protocol: HTTP
+      hostname: conflict.example.com
+    - name: http-second
+      port: 80
+      protocol: HTTP
+      hostname: conflict.example.com
+    - name: https
+      port: 443
+      protocol: HTTPS
+      hostname: conflict.example.com
+`,
+		expected: []unstructured.Unstructured{{
+			Object: map[string]interface{}{
+				"apiVersion": "gateway.networking.k8s.io/v1beta1",
+				"kind":       "Gateway",
+				"metadata": map[string]interface{}{
+					"name": "test",
+				},
+				"spec": map[string]interface{}{
+					"gatewayClassName": "test-class",
+					"listeners": []interface{}{
+						map[string]interface{}{
+							"name":     "http-first",
+							"port":     int64(8000),
+							"protocol": "HTTP",
+							"hostname": "conflict.example.com",
+						},
+						map[string]interface{}{
+							"name":     "http-second",
+							"port":     int64(8000),
+							"protocol": "HTTP",
+							"hostname": "conflict.example.com",
+						},
+						map[string]interface{}{
+							"name":     "https",
+							"port":     int64(8001),
+							"protocol": "HTTPS",
+							"hostname": "conflict.example.com",
+						},
+					},
+				},
+			},
+		}},
 	}}
 
 	for _, tc := range tests {
@@ -290,10 +359,177 @@ spec:
 
 			tc.applier.GatewayClass = "test-class"
 			tc.applier.ControllerName = "test-controller"
-			resources, err := tc.applier.prepareResources(t, decoder)
+			resources, err := tc.applier.prepareResources(t, decoder, config.DefaultTimeoutConfig())
 
 			require.NoError(t, err, "unexpected error preparing resources")
 			require.EqualValues(t, tc.expected, resources)
 		})
 	}
 }
+
+func TestPrepareResourcesSharedListenerPorts(t *testing.T) {
+	// These tests rely on listeners of the same Gateway sharing a port, which
+	// must hold when listener ports are handed out to parallel tests.
+	applier := Applier{
+		ListenerPorts: NewListenerPortPool([]v1beta1.PortNumber{8000, 8001, 8002, 8003}),
+	}
+	for _, location := range []string{
+		"tests/gateway-https-listeners.yaml",
+		"tests/gateway-listener-conflicts.yaml",
+	} {
+		t.Run(location, func(t *testing.T) {
+			data, err := getContents(location, config.DefaultTimeoutConfig(), applier.manifestFS())
+			require.NoError(t, err)
+			resources, err := applier.prepareResources(t, yaml.NewYAMLOrJSONDecoder(data, 4096), config.DefaultTimeoutConfig())
+			require.NoError(t, err)
+
+			for _, uObj := range resources {
+				if uObj.GetKind() != "Gateway" {
+					continue
+				}
+				listeners, _, err := unstructured.NestedSlice(uObj.Object, "spec", "listeners")
+				require.NoError(t, err)
+				require.Greater(t, len(listeners), 1, "Gateway %s must have several listeners", uObj.GetName())
+				ports := map[int64]bool{}
+				for _, listener := range listeners {
+					port, _, _ := unstructured.NestedInt64(listener.(map[string]interface{}), "port")
+					ports[port] = true
+				}
+				require.Len(t, ports, 1, "the listeners of Gateway %s must share a port", uObj.GetName())
+			}
+		})
+	}
+}
+
+func TestApplierManifestFS(t *testing.T) {
+	extensionFS := fstest.MapFS{
+		"extensions/filter.yaml": &fstest.MapFile{Data: []byte("kind: Filter")},
+		"base/manifests.yaml":    &fstest.MapFile{Data: []byte("kind: Shadowed")},
+	}
+	applier := Applier{ManifestFS: []fs.FS{extensionFS}}
+
+	data, err := getContents("extensions/filter.yaml", config.DefaultTimeoutConfig(), applier.manifestFS())
+	require.NoError(t, err)
+	require.Equal(t, "kind: Filter", data.String())
+
+	data, err = getContents("base/manifests.yaml", config.DefaultTimeoutConfig(), applier.manifestFS())
+	require.NoError(t, err)
+	require.NotEqual(t, "kind: Shadowed", data.String(), "embedded manifests must take precedence")
+
+	_, err = getContents("extensions/missing.yaml", config.DefaultTimeoutConfig(), applier.manifestFS())
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestGetContents(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b-route.yaml"), []byte("kind: HTTPRoute"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a-gateway.yml"), []byte("kind: Gateway"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a manifest"), 0o600))
+
+	primaryFS := fstest.MapFS{
+		"base/manifests.yaml":   &fstest.MapFile{Data: []byte("kind: Replaced")},
+		"tests/a-gateway.yaml":  &fstest.MapFile{Data: []byte("kind: Gateway")},
+		"tests/b-route.yaml":    &fstest.MapFile{Data: []byte("kind: HTTPRoute")},
+		"tests/nested/skip.yml": &fstest.MapFile{Data: []byte("kind: Skipped")},
+	}
+
+	testCases := []struct {
+		name     string
+		location string
+		fsys     []fs.FS
+		expected string
+	}{{
+		name:     "file from the first filesystem",
+		location: "base/manifests.yaml",
+		fsys:     []fs.FS{primaryFS},
+		expected: "kind: Replaced",
+	}, {
+		name:     "directory from a filesystem",
+		location: "tests",
+		fsys:     []fs.FS{fstest.MapFS{}, primaryFS},
+		expected: "\n---\nkind: Gateway\n---\nkind: HTTPRoute",
+	}, {
+		name:     "local file",
+		location: filepath.Join(dir, "b-route.yaml"),
+		expected: "kind: HTTPRoute",
+	}, {
+		name:     "local directory",
+		location: dir,
+		expected: "\n---\nkind: Gateway\n---\nkind: HTTPRoute",
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := getContents(tc.location, config.DefaultTimeoutConfig(), tc.fsys)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, data.String())
+		})
+	}
+
+	_, err := getContents("./missing.yaml", config.DefaultTimeoutConfig(), []fs.FS{primaryFS})
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestRenderManifest(t *testing.T) {
+	values := &ManifestTemplateValues{
+		GatewayClassName: "test-class",
+		Namespaces:       ManifestNamespaces{Infra: "gateway-conformance-infra"},
+		CertificateSecrets: map[string]types.NamespacedName{
+			"certificate": {Namespace: "gateway-conformance-web-backend", Name: "certificate"},
+		},
+		Values: map[string]string{"filter": "acme-filter"},
+	}
+
+	data, err := renderManifest("test.yaml", bytes.NewBufferString(
+		`gatewayClassName: {{ .GatewayClassName }}
+namespace: {{ .Namespaces.Infra }}
+certificateNamespace: {{ (index .CertificateSecrets "certificate").Namespace }}
+filter: {{ .Values.filter }}`), values)
+	require.NoError(t, err)
+	require.Equal(t, `gatewayClassName: test-class
+namespace: gateway-conformance-infra
+certificateNamespace: gateway-conformance-web-backend
+filter: acme-filter`, data.String())
+
+	_, err = renderManifest("test.yaml", bytes.NewBufferString("filter: {{ .Values.missing }}"), values)
+	require.Error(t, err, "missing values must not render as empty strings")
+}
+
+func TestToUnstructured(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "certificate", Namespace: "gateway-conformance-infra"},
+		Data:       map[string][]byte{"tls.crt": []byte("cert")},
+	}
+
+	uObj, err := toUnstructured(c, secret)
+	require.NoError(t, err)
+	require.Equal(t, "v1", uObj.GetAPIVersion())
+	require.Equal(t, "Secret", uObj.GetKind())
+	require.Equal(t, "certificate", uObj.GetName())
+	_, found, _ := unstructured.NestedFieldNoCopy(uObj.Object, "metadata", "creationTimestamp")
+	require.False(t, found, "creationTimestamp must not be applied")
+}
+
+func TestFromUnstructured(t *testing.T) {
+	created := metav1.NewTime(time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC))
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":              "certificate",
+			"namespace":         "gateway-conformance-infra",
+			"creationTimestamp": created.UTC().Format(time.RFC3339),
+			"resourceVersion":   "42",
+		},
+	}}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "certificate", Namespace: "gateway-conformance-infra"}}
+	require.NoError(t, fromUnstructured(live, secret))
+	require.True(t, created.Equal(&secret.CreationTimestamp), "expected creationTimestamp %v, got %v", created, secret.CreationTimestamp)
+	require.Equal(t, "42", secret.ResourceVersion)
+
+	uSecret := &unstructured.Unstructured{}
+	require.NoError(t, fromUnstructured(live, uSecret))
+	require.Equal(t, "42", uSecret.GetResourceVersion())
+}
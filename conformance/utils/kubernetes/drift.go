@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// checkDrift compares the spec of a resource in the cluster with the spec it
+// was applied with, and reports the fields that were changed when another
+// field manager than the tests themselves manages its spec.
+func (a Applier) checkDrift(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, applied *unstructured.Unstructured) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.GetTimeout)
+	defer cancel()
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(applied.GroupVersionKind())
+	err := c.Get(ctx, client.ObjectKeyFromObject(applied), live)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			t.Logf("Unable to check %s %s for drift: %v", applied.GetName(), applied.GetKind(), err)
+		}
+		return
+	}
+
+	drifted := specDrift(applied.Object["spec"], live.Object["spec"], "spec")
+	if len(drifted) == 0 {
+		return
+	}
+	managers := foreignSpecManagers(live.GetManagedFields(), FieldManager, testFieldManager())
+	if len(managers) == 0 {
+		return
+	}
+
+	report := t.Logf
+	if a.FailOnDrift {
+		report = t.Errorf
+	}
+	report("Drift detected for %s %s/%s: fields %s were changed, spec is also managed by %s",
+		applied.GetKind(), applied.GetNamespace(), applied.GetName(), strings.Join(drifted, ", "), strings.Join(managers, ", "))
+}
+
+// specDrift returns the paths of the fields set in the applied value that have
+// a different value in the live one. Fields that are only set in the live
+// value, such as defaults, are not drift.
+func specDrift(applied, live interface{}, path string) []string {
+	switch appliedValue := applied.(type) {
+	case map[string]interface{}:
+		liveValue, ok := live.(map[string]interface{})
+		if !ok {
+			return []string{path}
+		}
+		var drifted []string
+		for key, value := range appliedValue {
+			drifted = append(drifted, specDrift(value, liveValue[key], path+"."+key)...)
+		}
+		sort.Strings(drifted)
+		return drifted
+	case []interface{}:
+		liveValue, ok := live.([]interface{})
+		if !ok || len(liveValue) != len(appliedValue) {
+			return []string{path}
+		}
+		var drifted []string
+		for i, value := range appliedValue {
+			drifted = append(drifted, specDrift(value, liveValue[i], fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return drifted
+	case nil:
+		return nil
+	default:
+		if !reflect.DeepEqual(applied, live) {
+			return []string{path}
+		}
+		return nil
+	}
+}
+
+// foreignSpecManagers returns the field managers, other than the ignored
+// ones, that manage fields of the spec of a resource. Status updates are not
+// taken into account.
+func foreignSpecManagers(managedFields []metav1.ManagedFieldsEntry, ignored ...string) []string {
+	var managers []string
+	for _, entry := range managedFields {
+		if entry.Subresource != "" || entry.FieldsV1 == nil || contains(ignored, entry.Manager) || contains(managers, entry.Manager) {
+			continue
+		}
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		if _, ok := fields["f:spec"]; ok {
+			managers = append(managers, entry.Manager)
+		}
+	}
+	sort.Strings(managers)
+	return managers
+}
+
+// testFieldManager returns the field manager the API server records for the
+// updates made by the tests, which is derived from the default user agent of
+// the test binary.
+func testFieldManager() string {
+	return strings.Split(rest.DefaultKubernetesUserAgent(), "/")[0]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
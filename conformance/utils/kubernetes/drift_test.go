@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSpecDrift(t *testing.T) {
+	applied := map[string]interface{}{
+		"gatewayClassName": "test-class",
+		"listeners": []interface{}{
+			map[string]interface{}{"name": "http", "port": int64(80), "protocol": "HTTP"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		live     map[string]interface{}
+		expected []string
+	}{{
+		name: "unchanged with defaults",
+		live: map[string]interface{}{
+			"gatewayClassName": "test-class",
+			"listeners": []interface{}{
+				map[string]interface{}{
+					"name": "http", "port": int64(80), "protocol": "HTTP",
+					"allowedRoutes": map[string]interface{}{"namespaces": map[string]interface{}{"from": "Same"}},
+				},
+			},
+		},
+	}, {
+		name: "changed fields",
+		live: map[string]interface{}{
+			"gatewayClassName": "other-class",
+			"listeners": []interface{}{
+				map[string]interface{}{"name": "http", "port": int64(8080), "protocol": "HTTP"},
+			},
+		},
+		expected: []string{"spec.gatewayClassName", "spec.listeners[0].port"},
+	}, {
+		name: "removed list items",
+		live: map[string]interface{}{
+			"gatewayClassName": "test-class",
+			"listeners":        []interface{}{},
+		},
+		expected: []string{"spec.listeners"},
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, specDrift(applied, tc.live, "spec"))
+		})
+	}
+}
+
+func TestForeignSpecManagers(t *testing.T) {
+	managedFields := []metav1.ManagedFieldsEntry{{
+		Manager:  FieldManager,
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:gatewayClassName":{}}}`)},
+	}, {
+		Manager:     "acme-controller",
+		Subresource: "status",
+		FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:conditions":{}}}`)},
+	}, {
+		Manager:  "acme-webhook",
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:annotations":{}}}`)},
+	}, {
+		Manager:  "acme-controller",
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:listeners":{}}}`)},
+	}, {
+		Manager:  "conformance.test",
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:addresses":{}}}`)},
+	}}
+
+	require.Equal(t, []string{"acme-controller"}, foreignSpecManagers(managedFields, FieldManager, "conformance.test"))
+}
@@ -525,6 +525,99 @@ func GatewayStatusMustHaveListeners(t *testing.T, client client.Client, timeoutC
 	require.NoErrorf(t, waitErr, "error waiting for Gateway status to have listeners matching expectations")
 }
 
+// GatewayMustHaveCondition checks that the supplied Gateway has the supplied
+// Condition, halting after the specified timeout is exceeded.
+func GatewayMustHaveCondition(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, gwNN types.NamespacedName, condition metav1.Condition) {
+	t.Helper()
+
+	waitErr := wait.PollImmediate(1*time.Second, timeoutConfig.GatewayMustHaveCondition, func() (bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.GetTimeout)
+		defer cancel()
+
+		gw := &v1beta1.Gateway{}
+		err := client.Get(ctx, gwNN, gw)
+		if err != nil {
+			return false, fmt.Errorf("error fetching Gateway: %w", err)
+		}
+
+		if err := ConditionsHaveLatestObservedGeneration(gw, gw.Status.Conditions); err != nil {
+			t.Log("Gateway", err)
+			return false, nil
+		}
+
+		return findConditionInList(t, gw.Status.Conditions, condition.Type, string(condition.Status), condition.Reason), nil
+	})
+
+	require.NoErrorf(t, waitErr, "error waiting for Gateway status to have a Condition matching expectations")
+}
+
+// GatewayListenerMustHaveCondition checks that the named listener of the
+// supplied Gateway has the supplied Condition, halting after the specified
+// timeout is exceeded.
+func GatewayListenerMustHaveCondition(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, gwNN types.NamespacedName, listenerName string, condition metav1.Condition) {
+	t.Helper()
+
+	waitErr := wait.PollImmediate(1*time.Second, timeoutConfig.GatewayMustHaveCondition, func() (bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.GetTimeout)
+		defer cancel()
+
+		gw := &v1beta1.Gateway{}
+		err := client.Get(ctx, gwNN, gw)
+		if err != nil {
+			return false, fmt.Errorf("error fetching Gateway: %w", err)
+		}
+
+		for _, listener := range gw.Status.Listeners {
+			if string(listener.Name) != listenerName {
+				continue
+			}
+			if err := ConditionsHaveLatestObservedGeneration(gw, listener.Conditions); err != nil {
+				t.Logf("Gateway(listener=%s) %v", listenerName, err)
+				return false, nil
+			}
+			return findConditionInList(t, listener.Conditions, condition.Type, string(condition.Status), condition.Reason), nil
+		}
+
+		t.Logf("Gateway has no status for listener %s", listenerName)
+		return false, nil
+	})
+
+	require.NoErrorf(t, waitErr, "error waiting for Gateway listener %s status to have a Condition matching expectations", listenerName)
+}
+
+// GatewayListenerMustHaveAttachedRoutes waits for the specified listener of
+// the supplied Gateway to report the given number of attached Routes.
+func GatewayListenerMustHaveAttachedRoutes(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, gwNN types.NamespacedName, listenerName string, attachedRoutes int32) {
+	t.Helper()
+
+	waitErr := wait.PollImmediate(1*time.Second, timeoutConfig.GatewayStatusMustHaveListeners, func() (bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.GetTimeout)
+		defer cancel()
+
+		gw := &v1beta1.Gateway{}
+		err := client.Get(ctx, gwNN, gw)
+		if err != nil {
+			return false, fmt.Errorf("error fetching Gateway: %w", err)
+		}
+
+		for _, listener := range gw.Status.Listeners {
+			if string(listener.Name) != listenerName {
+				continue
+			}
+			if listener.AttachedRoutes != attachedRoutes {
+				t.Logf("Gateway(listener=%s) expected %d attached Routes, got %d", listenerName, attachedRoutes, listener.AttachedRoutes)
+				return false, nil
+			}
+			return true, nil
+		}
+
+		t.Logf("Gateway has no status for listener %s", listenerName)
+		return false, nil
+	})
+
+	require.NoErrorf(t, waitErr, "error waiting for Gateway listener %s to have %d attached Routes", listenerName, attachedRoutes)
+}
+
 // HTTPRouteMustHaveCondition checks that the supplied HTTPRoute has the supplied Condition,
 // halting after the specified timeout is exceeded.
 func HTTPRouteMustHaveCondition(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, routeNN types.NamespacedName, gwNN types.NamespacedName, condition metav1.Condition) {
@@ -627,6 +720,175 @@ func GatewayAndTLSRoutesMustBeAccepted(t *testing.T, c client.Client, timeoutCon
 	return gwAddr, hostnames
 }
 
+// GatewayAndTCPRoutesMustBeAccepted waits until the specified Gateway has an IP
+// address assigned to it and the TCPRoutes have a ParentRef referring to the
+// Gateway. The test will fail if these conditions are not met before the
+// timeouts.
+func GatewayAndTCPRoutesMustBeAccepted(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, controllerName string, gw GatewayRef, routeNNs ...types.NamespacedName) string {
+	t.Helper()
+
+	gwAddr, err := WaitForGatewayAddress(t, c, timeoutConfig, gw.NamespacedName)
+	require.NoErrorf(t, err, "timed out waiting for Gateway address to be assigned")
+
+	for _, routeNN := range routeNNs {
+		TCPRouteMustHaveParents(t, c, timeoutConfig, routeNN, acceptedRouteParents(controllerName, gw), routeNN.Namespace != gw.Namespace)
+	}
+
+	return gwAddr
+}
+
+// GatewayAndUDPRoutesMustBeAccepted waits until the specified Gateway has an IP
+// address assigned to it and the UDPRoutes have a ParentRef referring to the
+// Gateway. The test will fail if these conditions are not met before the
+// timeouts.
+func GatewayAndUDPRoutesMustBeAccepted(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, controllerName string, gw GatewayRef, routeNNs ...types.NamespacedName) string {
+	t.Helper()
+
+	gwAddr, err := WaitForGatewayAddress(t, c, timeoutConfig, gw.NamespacedName)
+	require.NoErrorf(t, err, "timed out waiting for Gateway address to be assigned")
+
+	for _, routeNN := range routeNNs {
+		UDPRouteMustHaveParents(t, c, timeoutConfig, routeNN, acceptedRouteParents(controllerName, gw), routeNN.Namespace != gw.Namespace)
+	}
+
+	return gwAddr
+}
+
+// GatewayAndGRPCRoutesMustBeAccepted waits until the specified Gateway has an
+// IP address assigned to it and the GRPCRoutes have a ParentRef referring to
+// the Gateway. The test will fail if these conditions are not met before the
+// timeouts.
+func GatewayAndGRPCRoutesMustBeAccepted(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, controllerName string, gw GatewayRef, routeNNs ...types.NamespacedName) string {
+	t.Helper()
+
+	gwAddr, err := WaitForGatewayAddress(t, c, timeoutConfig, gw.NamespacedName)
+	require.NoErrorf(t, err, "timed out waiting for Gateway address to be assigned")
+
+	for _, routeNN := range routeNNs {
+		GRPCRouteMustHaveParents(t, c, timeoutConfig, routeNN, acceptedRouteParents(controllerName, gw), routeNN.Namespace != gw.Namespace)
+	}
+
+	return gwAddr
+}
+
+// GRPCRouteMustHaveParents waits for the specified GRPCRoute to have parents
+// in status that match the expected parents, and also returns the GRPCRoute.
+// This will cause the test to halt if the specified timeout is exceeded.
+func GRPCRouteMustHaveParents(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, routeName types.NamespacedName, parents []v1alpha2.RouteParentStatus, namespaceRequired bool) v1alpha2.GRPCRoute {
+	t.Helper()
+
+	var route v1alpha2.GRPCRoute
+	routeMustHaveParents(t, client, timeoutConfig, routeName, &route, func() []v1beta1.RouteParentStatus { return route.Status.Parents }, parents, namespaceRequired)
+	return route
+}
+
+// TCPRouteMustHaveParents waits for the specified TCPRoute to have parents
+// in status that match the expected parents, and also returns the TCPRoute.
+// This will cause the test to halt if the specified timeout is exceeded.
+func TCPRouteMustHaveParents(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, routeName types.NamespacedName, parents []v1alpha2.RouteParentStatus, namespaceRequired bool) v1alpha2.TCPRoute {
+	t.Helper()
+
+	var route v1alpha2.TCPRoute
+	routeMustHaveParents(t, client, timeoutConfig, routeName, &route, func() []v1beta1.RouteParentStatus { return route.Status.Parents }, parents, namespaceRequired)
+	return route
+}
+
+// UDPRouteMustHaveParents waits for the specified UDPRoute to have parents
+// in status that match the expected parents, and also returns the UDPRoute.
+// This will cause the test to halt if the specified timeout is exceeded.
+func UDPRouteMustHaveParents(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, routeName types.NamespacedName, parents []v1alpha2.RouteParentStatus, namespaceRequired bool) v1alpha2.UDPRoute {
+	t.Helper()
+
+	var route v1alpha2.UDPRoute
+	routeMustHaveParents(t, client, timeoutConfig, routeName, &route, func() []v1beta1.RouteParentStatus { return route.Status.Parents }, parents, namespaceRequired)
+	return route
+}
+
+// routeMustHaveParents waits for the route to have parents in status that
+// match the expected parents. The route is fetched into the provided object
+// and its parents are read with the provided function.
+func routeMustHaveParents(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, routeName types.NamespacedName, route client.Object, routeParents func() []v1beta1.RouteParentStatus, parents []v1beta1.RouteParentStatus, namespaceRequired bool) {
+	t.Helper()
+
+	kind := reflect.TypeOf(route).Elem().Name()
+	waitErr := wait.PollImmediate(1*time.Second, timeoutConfig.RouteMustHaveParents, func() (bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		err := client.Get(ctx, routeName, route)
+		if err != nil {
+			return false, fmt.Errorf("error fetching %s: %w", kind, err)
+		}
+		return parentsForRouteMatch(t, routeName, parents, routeParents(), namespaceRequired), nil
+	})
+	require.NoErrorf(t, waitErr, "error waiting for %s to have parents matching expectations", kind)
+}
+
+// acceptedRouteParents returns the parents a route attached to the listeners
+// of the Gateway is expected to have in status.
+func acceptedRouteParents(controllerName string, gw GatewayRef) []v1beta1.RouteParentStatus {
+	ns := v1beta1.Namespace(gw.Namespace)
+	kind := v1beta1.Kind("Gateway")
+
+	var parents []v1beta1.RouteParentStatus
+	for _, listener := range gw.listenerNames {
+		parents = append(parents, v1beta1.RouteParentStatus{
+			ParentRef: v1beta1.ParentReference{
+				Group:       (*v1beta1.Group)(&v1beta1.GroupVersion.Group),
+				Kind:        &kind,
+				Name:        v1beta1.ObjectName(gw.Name),
+				Namespace:   &ns,
+				SectionName: listener,
+			},
+			ControllerName: v1beta1.GatewayController(controllerName),
+			Conditions: []metav1.Condition{
+				{
+					Type:   string(v1beta1.RouteConditionAccepted),
+					Status: metav1.ConditionTrue,
+					Reason: string(v1beta1.RouteReasonAccepted),
+				},
+			},
+		})
+	}
+	return parents
+}
+
+// GatewayListenerPort returns the port of the named listener of the specified
+// Gateway. This may differ from the port in the manifests the Gateway was
+// created from, e.g. when the suite is configured with
+// ValidUniqueListenerPorts.
+func GatewayListenerPort(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, gwNN types.NamespacedName, listenerName string) v1beta1.PortNumber {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.GetTimeout)
+	defer cancel()
+
+	gw := &v1beta1.Gateway{}
+	err := client.Get(ctx, gwNN, gw)
+	require.NoErrorf(t, err, "error fetching Gateway")
+
+	for _, listener := range gw.Spec.Listeners {
+		if string(listener.Name) == listenerName {
+			return listener.Port
+		}
+	}
+	t.Fatalf("Gateway %s has no listener named %s", gwNN, listenerName)
+	return 0
+}
+
+// GatewayListenerAddress returns the address of the named listener of the
+// specified Gateway, made of the host of the Gateway address, as returned by
+// WaitForGatewayAddress, and the port of the listener.
+func GatewayListenerAddress(t *testing.T, client client.Client, timeoutConfig config.TimeoutConfig, gwNN types.NamespacedName, gwAddr, listenerName string) string {
+	t.Helper()
+
+	host, _, err := net.SplitHostPort(gwAddr)
+	require.NoErrorf(t, err, "error parsing Gateway address %s", gwAddr)
+
+	port := GatewayListenerPort(t, client, timeoutConfig, gwNN, listenerName)
+	return net.JoinHostPort(host, strconv.Itoa(int(port)))
+}
+
 // TODO(mikemorris): this and parentsMatch could possibly be rewritten as a generic function?
 func listenersMatch(t *testing.T, expected, actual []v1beta1.ListenerStatus) bool {
 	t.Helper()
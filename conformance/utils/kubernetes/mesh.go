@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// ServiceAndHTTPRoutesMustBeAccepted waits until the Routes have a ParentRef
+// referring to the specified Service accepted by the mesh, and returns the
+// address requests to the Service are made to from within the cluster. When
+// controllerName is empty, the parent may have been accepted by any
+// controller. The test will fail if these conditions are not met before the
+// timeouts.
+func ServiceAndHTTPRoutesMustBeAccepted(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, controllerName string, svcNN types.NamespacedName, routeNNs ...types.NamespacedName) string {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.GetTimeout)
+	defer cancel()
+
+	svc := &v1.Service{}
+	require.NoErrorf(t, c.Get(ctx, svcNN, svc), "error fetching Service %s", svcNN)
+	require.NotEmptyf(t, svc.Spec.Ports, "Service %s has no ports", svcNN)
+
+	for _, routeNN := range routeNNs {
+		waitErr := wait.PollImmediate(1*time.Second, timeoutConfig.RouteMustHaveParents, func() (bool, error) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			route := &v1beta1.HTTPRoute{}
+			if err := c.Get(ctx, routeNN, route); err != nil {
+				return false, fmt.Errorf("error fetching HTTPRoute: %w", err)
+			}
+			return serviceParentAccepted(t, route, controllerName, svcNN), nil
+		})
+		require.NoErrorf(t, waitErr, "error waiting for HTTPRoute %s to be accepted by Service %s", routeNN, svcNN)
+	}
+
+	host := fmt.Sprintf("%s.%s", svcNN.Name, svcNN.Namespace)
+	return net.JoinHostPort(host, strconv.Itoa(int(svc.Spec.Ports[0].Port)))
+}
+
+// serviceParentAccepted returns true if the route has a parent in status
+// referring to the Service, with an Accepted condition set to True for the
+// latest generation of the route.
+func serviceParentAccepted(t *testing.T, route *v1beta1.HTTPRoute, controllerName string, svcNN types.NamespacedName) bool {
+	t.Helper()
+
+	for _, parent := range route.Status.Parents {
+		ref := parent.ParentRef
+		if ref.Kind == nil || *ref.Kind != "Service" || (ref.Group != nil && *ref.Group != "") || string(ref.Name) != svcNN.Name {
+			continue
+		}
+		if ref.Namespace != nil && string(*ref.Namespace) != svcNN.Namespace {
+			continue
+		}
+		if controllerName != "" && string(parent.ControllerName) != controllerName {
+			continue
+		}
+		if err := ConditionsHaveLatestObservedGeneration(route, parent.Conditions); err != nil {
+			t.Logf("HTTPRoute %s/%s %v", route.Namespace, route.Name, err)
+			return false
+		}
+		return findConditionInList(t, parent.Conditions, string(v1beta1.RouteConditionAccepted), string(metav1.ConditionTrue), "")
+	}
+
+	t.Logf("HTTPRoute %s/%s has no parent for Service %s", route.Namespace, route.Name, svcNN)
+	return false
+}
+
+// PodMustBeRunning waits until a Pod matching the label selector is running
+// and ready in the namespace, and returns its name.
+func PodMustBeRunning(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, namespace string, selector map[string]string) types.NamespacedName {
+	t.Helper()
+
+	var podNN types.NamespacedName
+	waitErr := wait.PollImmediate(1*time.Second, timeoutConfig.NamespacesMustBeReady, func() (bool, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		podList := &v1.PodList{}
+		if err := c.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabels(selector)); err != nil {
+			t.Errorf("Error listing Pods: %v", err)
+			return false, nil
+		}
+		for _, pod := range podList.Items {
+			if pod.DeletionTimestamp == nil && pod.Status.Phase == v1.PodRunning && findPodConditionInList(t, pod.Status.Conditions, "Ready", "True") {
+				podNN = types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+				return true, nil
+			}
+		}
+		t.Logf("Waiting for a running Pod matching %v in %s", selector, namespace)
+		return false, nil
+	})
+	require.NoErrorf(t, waitErr, "error waiting for a running Pod matching %v in %s", selector, namespace)
+	return podNN
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func TestServiceParentAccepted(t *testing.T) {
+	svcNN := types.NamespacedName{Namespace: "gateway-conformance-mesh", Name: "echo"}
+	coreGroup := v1beta1.Group("")
+	serviceKind := v1beta1.Kind("Service")
+	gatewayKind := v1beta1.Kind("Gateway")
+	otherNamespace := v1beta1.Namespace("other")
+
+	parent := func(kind *v1beta1.Kind, name string, namespace *v1beta1.Namespace, status metav1.ConditionStatus, generation int64) v1beta1.RouteParentStatus {
+		return v1beta1.RouteParentStatus{
+			ParentRef:      v1beta1.ParentReference{Group: &coreGroup, Kind: kind, Name: v1beta1.ObjectName(name), Namespace: namespace},
+			ControllerName: "example.com/mesh",
+			Conditions: []metav1.Condition{{
+				Type:               string(v1beta1.RouteConditionAccepted),
+				Status:             status,
+				Reason:             string(v1beta1.RouteReasonAccepted),
+				ObservedGeneration: generation,
+			}},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		parents        []v1beta1.RouteParentStatus
+		controllerName string
+		expected       bool
+	}{
+		{
+			name:     "accepted Service parent",
+			parents:  []v1beta1.RouteParentStatus{parent(&serviceKind, "echo", nil, metav1.ConditionTrue, 1)},
+			expected: true,
+		},
+		{
+			name:           "accepted Service parent of the expected controller",
+			parents:        []v1beta1.RouteParentStatus{parent(&serviceKind, "echo", nil, metav1.ConditionTrue, 1)},
+			controllerName: "example.com/mesh",
+			expected:       true,
+		},
+		{
+			name:           "accepted Service parent of another controller",
+			parents:        []v1beta1.RouteParentStatus{parent(&serviceKind, "echo", nil, metav1.ConditionTrue, 1)},
+			controllerName: "example.com/other",
+		},
+		{
+			name:    "Service parent not accepted",
+			parents: []v1beta1.RouteParentStatus{parent(&serviceKind, "echo", nil, metav1.ConditionFalse, 1)},
+		},
+		{
+			name:    "Service parent accepted for a previous generation",
+			parents: []v1beta1.RouteParentStatus{parent(&serviceKind, "echo", nil, metav1.ConditionTrue, 0)},
+		},
+		{
+			name:    "Service parent in another namespace",
+			parents: []v1beta1.RouteParentStatus{parent(&serviceKind, "echo", &otherNamespace, metav1.ConditionTrue, 1)},
+		},
+		{
+			name:    "Gateway parent with the name of the Service",
+			parents: []v1beta1.RouteParentStatus{parent(&gatewayKind, "echo", nil, metav1.ConditionTrue, 1)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			route := &v1beta1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: svcNN.Namespace, Name: "route", Generation: 1},
+				Status:     v1beta1.HTTPRouteStatus{RouteStatus: v1beta1.RouteStatus{Parents: test.parents}},
+			}
+			assert.Equal(t, test.expected, serviceParentAccepted(t, route, test.controllerName, svcNN))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// ListenerPortPool hands out ports from a fixed set of valid, unique listener
+// ports. It is safe for concurrent use and guarantees that a port is never
+// assigned to more than one Gateway listener at the same time, which allows
+// manifests to be applied by tests running in parallel.
+type ListenerPortPool struct {
+	lock  sync.Mutex
+	ports []v1beta1.PortNumber
+	inUse map[v1beta1.PortNumber]bool
+}
+
+// NewListenerPortPool returns a ListenerPortPool for the provided ports.
+func NewListenerPortPool(ports []v1beta1.PortNumber) *ListenerPortPool {
+	return &ListenerPortPool{
+		ports: ports,
+		inUse: map[v1beta1.PortNumber]bool{},
+	}
+}
+
+// reserve waits until n ports are available and marks them as in use. Ports
+// are handed out in the order they were given to the pool. An error is
+// returned if the pool is too small or the ports don't become available
+// before the timeout.
+func (p *ListenerPortPool) reserve(n int, timeout time.Duration) ([]v1beta1.PortNumber, error) {
+	if n > len(p.ports) {
+		return nil, fmt.Errorf("not enough valid listener ports: %d required, %d available in total", n, len(p.ports))
+	}
+
+	var reserved []v1beta1.PortNumber
+	err := wait.PollImmediate(100*time.Millisecond, timeout, func() (bool, error) {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+
+		var free []v1beta1.PortNumber
+		for _, port := range p.ports {
+			if !p.inUse[port] {
+				free = append(free, port)
+			}
+			if len(free) == n {
+				break
+			}
+		}
+		if len(free) < n {
+			return false, nil
+		}
+
+		for _, port := range free {
+			p.inUse[port] = true
+		}
+		reserved = free
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("timed out waiting for %d valid listener ports to be released: %w", n, err)
+	}
+	return reserved, nil
+}
+
+// release returns the provided ports to the pool.
+func (p *ListenerPortPool) release(ports []v1beta1.PortNumber) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, port := range ports {
+		delete(p.inUse, port)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func TestListenerPortPool(t *testing.T) {
+	pool := NewListenerPortPool([]v1beta1.PortNumber{8080, 8081, 8082})
+
+	first, err := pool.reserve(2, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []v1beta1.PortNumber{8080, 8081}, first)
+
+	second, err := pool.reserve(1, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []v1beta1.PortNumber{8082}, second)
+
+	_, err = pool.reserve(1, 200*time.Millisecond)
+	require.Error(t, err, "all ports should be in use")
+
+	pool.release(first)
+	third, err := pool.reserve(2, time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []v1beta1.PortNumber{8080, 8081}, third)
+
+	_, err = pool.reserve(4, time.Second)
+	require.Error(t, err, "more ports than the pool holds should never be reserved")
+}
+
+func TestListenerPortPoolWaitsForRelease(t *testing.T) {
+	pool := NewListenerPortPool([]v1beta1.PortNumber{8080})
+
+	reserved, err := pool.reserve(1, time.Second)
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		pool.release(reserved)
+	}()
+
+	reserved, err = pool.reserve(1, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, []v1beta1.PortNumber{8080}, reserved)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// ManifestTemplateValues are the values manifests are rendered with when they
+// are applied as Go templates, e.g.
+//
+//	gatewayClassName: "{{ .GatewayClassName }}"
+//	namespace: "{{ .Namespaces.Infra }}"
+//
+// Gateways are still set to use the GatewayClass under test when prepared,
+// whatever the gatewayClassName in their manifest.
+type ManifestTemplateValues struct {
+	// GatewayClassName is the name of the GatewayClass under test.
+	GatewayClassName string
+
+	// ControllerName is the controller name of the GatewayClass under test.
+	ControllerName string
+
+	// Namespaces are the namespaces created by the base manifests.
+	Namespaces ManifestNamespaces
+
+	// ListenerPorts are the valid unique listener ports of the suite, if any.
+	ListenerPorts []v1beta1.PortNumber
+
+	// CertificateSecrets maps the names of the certificate Secrets created
+	// by the suite to their namespaced names, e.g.
+	// `{{ (index .CertificateSecrets "tls-first-certificate").Namespace }}`.
+	CertificateSecrets map[string]types.NamespacedName
+
+	// Values are arbitrary values provided by implementations, e.g. for the
+	// manifests of their implementation-specific tests.
+	Values map[string]string
+}
+
+// ManifestNamespaces are the namespaces created by the base manifests.
+type ManifestNamespaces struct {
+	Infra      string
+	AppBackend string
+	WebBackend string
+	Mesh       string
+}
+
+// renderManifest renders the manifest read from the given location as a Go
+// template with the given values.
+func renderManifest(location string, data *bytes.Buffer, values *ManifestTemplateValues) (*bytes.Buffer, error) {
+	tmpl, err := template.New(location).Option("missingkey=error").Parse(data.String())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing manifest %s as a template: %w", location, err)
+	}
+
+	rendered := new(bytes.Buffer)
+	if err := tmpl.Execute(rendered, values); err != nil {
+		return nil, fmt.Errorf("error rendering manifest %s: %w", location, err)
+	}
+	return rendered, nil
+}
@@ -0,0 +1,375 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// ExecRoundTripper is a RoundTripper which makes requests from within the
+// cluster, by running curl in a client Pod with the exec subresource. It is
+// used to test east-west traffic in mesh mode, where requests are sent to
+// Services instead of Gateway addresses. TLS requests are not supported.
+type ExecRoundTripper struct {
+	Clientset  clientset.Interface
+	RestConfig *rest.Config
+
+	// Pod is the client Pod requests are made from, which must have curl in
+	// its PATH. Container is the container of the Pod curl is run in, which
+	// can be left empty if the Pod only has one.
+	Pod       types.NamespacedName
+	Container string
+
+	Debug         bool
+	TimeoutConfig config.TimeoutConfig
+
+	// History, when set, records the requests made with CaptureRoundTrip.
+	History *History
+}
+
+// CaptureRoundTrip makes a request with the provided parameters from the
+// client Pod and returns the captured request and response from echoserver.
+// An error will be returned if curl fails to make the request but not if an
+// HTTP error status code is received.
+func (e *ExecRoundTripper) CaptureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	start := time.Now()
+	cReq, cRes, err := e.captureRoundTrip(request)
+	if e.History != nil {
+		e.History.Record(recordRoundTrip(start, request, cReq, cRes, err))
+	}
+	return cReq, cRes, err
+}
+
+func (e *ExecRoundTripper) captureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	command, err := curlCommand(request, e.TimeoutConfig.RequestTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if e.Debug {
+		fmt.Printf("Running in %s:\n%s\n\n", e.Pod, strings.Join(command, " "))
+	}
+
+	var stdin io.Reader
+	if len(request.Body) > 0 {
+		stdin = bytes.NewReader(request.Body)
+	}
+
+	// The exec request is given more time than curl, so that the requests
+	// timing out are reported by curl.
+	ctx, cancel := context.WithTimeout(context.Background(), e.TimeoutConfig.RequestTimeout+10*time.Second)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	if err := e.exec(ctx, command, stdin, &stdout, &stderr); err != nil {
+		return nil, nil, fmt.Errorf("error running curl in %s: %w: %s", e.Pod, err, strings.TrimSpace(stderr.String()))
+	}
+
+	if e.Debug {
+		fmt.Printf("Received Response:\n%s\n\n", formatDump(stdout.Bytes(), "< "))
+	}
+
+	return parseCurlOutput(stdout.Bytes(), request.Method)
+}
+
+// CaptureBatchRoundTrip makes the request n times with a single curl command
+// run in the client Pod, and returns the captured requests and responses in
+// order. An error will be returned if curl fails to make any of the requests.
+// Requests with a body are made with separate commands, as the body can only
+// be read from stdin once.
+func (e *ExecRoundTripper) CaptureBatchRoundTrip(request Request, n int) ([]*CapturedRequest, []*CapturedResponse, error) {
+	cReqs := make([]*CapturedRequest, 0, n)
+	cRess := make([]*CapturedResponse, 0, n)
+	if len(request.Body) > 0 {
+		for i := 0; i < n; i++ {
+			cReq, cRes, err := e.CaptureRoundTrip(request)
+			if err != nil {
+				return nil, nil, err
+			}
+			cReqs = append(cReqs, cReq)
+			cRess = append(cRess, cRes)
+		}
+		return cReqs, cRess, nil
+	}
+
+	start := time.Now()
+	outputs, err := e.execBatch(request, n)
+	if err != nil {
+		if e.History != nil {
+			e.History.Record(recordRoundTrip(start, request, nil, nil, err))
+		}
+		return nil, nil, err
+	}
+	for _, output := range outputs {
+		cReq, cRes, err := parseCurlOutput(output, request.Method)
+		if e.History != nil {
+			e.History.Record(recordRoundTrip(start, request, cReq, cRes, err))
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		cReqs = append(cReqs, cReq)
+		cRess = append(cRess, cRes)
+	}
+	return cReqs, cRess, nil
+}
+
+// execBatch runs the curl command making the request n times in the client
+// Pod, and returns the output of each request.
+func (e *ExecRoundTripper) execBatch(request Request, n int) ([][]byte, error) {
+	command, err := curlBatchCommand(request, e.TimeoutConfig.RequestTimeout, n)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.Debug {
+		fmt.Printf("Running in %s:\n%s\n\n", e.Pod, strings.Join(command, " "))
+	}
+
+	// The exec request is given more time than curl, so that the requests
+	// timing out are reported by curl.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(n)*e.TimeoutConfig.RequestTimeout+10*time.Second)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	if err := e.exec(ctx, command, nil, &stdout, &stderr); err != nil {
+		return nil, fmt.Errorf("error running curl in %s: %w: %s", e.Pod, err, strings.TrimSpace(stderr.String()))
+	}
+
+	if e.Debug {
+		fmt.Printf("Received Responses:\n%s\n\n", formatDump(stdout.Bytes(), "< "))
+	}
+
+	return splitCurlBatchOutput(stdout.Bytes(), n)
+}
+
+// exec runs the command in the client Pod.
+func (e *ExecRoundTripper) exec(ctx context.Context, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if e.Clientset == nil || e.RestConfig == nil {
+		return errors.New("a Clientset and a RestConfig are required to exec in the client Pod")
+	}
+	if e.Pod.Name == "" {
+		return errors.New("no client Pod to exec in")
+	}
+
+	req := e.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(e.Pod.Namespace).
+		Name(e.Pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: e.Container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(e.RestConfig, http.MethodPost, req.URL())
+	if err != nil {
+		return err
+	}
+	return executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+}
+
+// curlCommand returns the curl command making the request and writing the
+// response, along with its headers, to stdout. The body of the request, if
+// any, is read from stdin.
+func curlCommand(request Request, timeout time.Duration) ([]string, error) {
+	if request.URL.Scheme != "http" {
+		return nil, fmt.Errorf("unsupported scheme %q, only http requests can be made from the client Pod", request.URL.Scheme)
+	}
+
+	command := []string{"curl", "--silent", "--show-error", "--include"}
+	if timeout > 0 {
+		command = append(command, "--max-time", strconv.Itoa(int(math.Ceil(timeout.Seconds()))))
+	}
+
+	switch request.HTTPVersion {
+	case HTTPVersionDefault, HTTPVersion1:
+		command = append(command, "--http1.1")
+	case HTTPVersionH2C:
+		command = append(command, "--http2-prior-knowledge")
+	default:
+		return nil, fmt.Errorf("unsupported HTTP version %q", request.HTTPVersion)
+	}
+
+	if !request.UnfollowRedirect {
+		command = append(command, "--location")
+	}
+
+	switch request.Method {
+	case "", http.MethodGet:
+	case http.MethodHead:
+		command = append(command, "--head")
+	default:
+		command = append(command, "--request", request.Method)
+	}
+
+	if request.Host != "" {
+		command = append(command, "--header", "Host: "+request.Host)
+	}
+	for name, values := range request.Headers {
+		for _, value := range values {
+			// curl removes headers passed without a value, unless their name
+			// is followed by a semicolon.
+			if value == "" {
+				command = append(command, "--header", name+";")
+				continue
+			}
+			command = append(command, "--header", name+": "+value)
+		}
+	}
+
+	if len(request.Body) > 0 {
+		command = append(command, "--data-binary", "@-")
+	}
+
+	return append(command, request.URL.String()), nil
+}
+
+// curlBatchSeparator is written by curl after each of the responses of a
+// batch of requests, on a line of its own.
+const curlBatchSeparator = "--- gateway-api-conformance-end-of-response ---"
+
+// curlBatchCommand returns the curl command making the request n times in a
+// row, and writing each response, along with its headers, to stdout followed
+// by curlBatchSeparator. Requests with a body are not supported.
+func curlBatchCommand(request Request, timeout time.Duration, n int) ([]string, error) {
+	if len(request.Body) > 0 {
+		return nil, errors.New("requests with a body cannot be made in a batch")
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("invalid number of requests %d", n)
+	}
+
+	command, err := curlCommand(request, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// curl applies the options to every URL it is given, and writes the
+	// --write-out format once the transfer of each of them is complete.
+	url := command[len(command)-1]
+	command = append(command[:len(command)-1], "--write-out", "\n"+curlBatchSeparator+"\n")
+	for i := 0; i < n; i++ {
+		command = append(command, url)
+	}
+	return command, nil
+}
+
+// splitCurlBatchOutput splits the output of curlBatchCommand into the output
+// of each of its n requests.
+func splitCurlBatchOutput(output []byte, n int) ([][]byte, error) {
+	outputs := bytes.Split(output, []byte("\n"+curlBatchSeparator+"\n"))
+	// The output ends with a separator, which leaves an empty last element.
+	if len(outputs) != n+1 || len(outputs[n]) != 0 {
+		return nil, fmt.Errorf("expected the output of %d requests, got %d", n, len(outputs)-1)
+	}
+	return outputs[:n], nil
+}
+
+// curlStatusLineRegex matches the HTTP/2 status lines written by curl, which
+// have no minor version.
+var curlStatusLineRegex = regexp.MustCompile(`(?m)^HTTP/2 `)
+
+// parseCurlOutput parses the responses written by curl for a request made
+// with the given method, and returns the request captured by echoserver, if
+// the last response is JSON, along with that response. The responses of the
+// redirects curl followed, if any, precede it.
+func parseCurlOutput(output []byte, method string) (*CapturedRequest, *CapturedResponse, error) {
+	reader := bufio.NewReader(bytes.NewReader(curlStatusLineRegex.ReplaceAll(output, []byte("HTTP/2.0 "))))
+	// The request tells ReadResponse whether the responses have a body.
+	req := &http.Request{Method: method}
+	if req.Method == "" {
+		req.Method = http.MethodGet
+	}
+
+	var resp *http.Response
+	var body []byte
+	for {
+		next, err := http.ReadResponse(reader, req)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unexpected error reading response: %w", err)
+		}
+		body, err = io.ReadAll(next.Body)
+		next.Body.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("unexpected error reading response body: %w", err)
+		}
+		resp = next
+
+		if _, err := reader.Peek(1); err == io.EOF {
+			break
+		}
+	}
+
+	cReq := &CapturedRequest{}
+	// we cannot assume the response is JSON
+	if resp.Header.Get("Content-type") == "application/json" {
+		if err := json.Unmarshal(body, cReq); err != nil {
+			return nil, nil, fmt.Errorf("unexpected error reading response: %w", err)
+		}
+	}
+
+	cRes := &CapturedResponse{
+		StatusCode:    resp.StatusCode,
+		ContentLength: resp.ContentLength,
+		Protocol:      resp.Proto,
+		Headers:       resp.Header,
+	}
+
+	if IsRedirect(resp.StatusCode) {
+		redirectURL, err := resp.Location()
+		if err != nil {
+			return nil, nil, err
+		}
+		cRes.RedirectRequest = &RedirectRequest{
+			Scheme: redirectURL.Scheme,
+			Host:   redirectURL.Hostname(),
+			Port:   redirectURL.Port(),
+			Path:   redirectURL.Path,
+		}
+	}
+
+	return cReq, cRes, nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurlCommand(t *testing.T) {
+	testCases := []struct {
+		name     string
+		request  Request
+		timeout  time.Duration
+		expected []string
+		err      string
+	}{
+		{
+			name:     "get request",
+			request:  Request{URL: url.URL{Scheme: "http", Host: "echo", Path: "/v1"}},
+			timeout:  1500 * time.Millisecond,
+			expected: []string{"curl", "--silent", "--show-error", "--include", "--max-time", "2", "--http1.1", "--location", "http://echo/v1"},
+		},
+		{
+			name: "request with host, headers and body",
+			request: Request{
+				URL:              url.URL{Scheme: "http", Host: "echo", Path: "/post"},
+				Host:             "echo.example.com",
+				Method:           "POST",
+				Headers:          map[string][]string{"X-Echo-Set-Header": {""}},
+				Body:             []byte("hello"),
+				HTTPVersion:      HTTPVersionH2C,
+				UnfollowRedirect: true,
+			},
+			expected: []string{"curl", "--silent", "--show-error", "--include", "--http2-prior-knowledge", "--request", "POST", "--header", "Host: echo.example.com", "--header", "X-Echo-Set-Header;", "--data-binary", "@-", "http://echo/post"},
+		},
+		{
+			name:    "https request",
+			request: Request{URL: url.URL{Scheme: "https", Host: "echo"}},
+			err:     `unsupported scheme "https"`,
+		},
+		{
+			name:    "http2 over TLS",
+			request: Request{URL: url.URL{Scheme: "http", Host: "echo"}, HTTPVersion: HTTPVersion2},
+			err:     `unsupported HTTP version "HTTP/2"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			command, err := curlCommand(tc.request, tc.timeout)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, command)
+		})
+	}
+}
+
+func TestParseCurlOutput(t *testing.T) {
+	echoResponse := strings.Join([]string{
+		"HTTP/1.1 200 OK",
+		"Content-Type: application/json",
+		"X-Custom: value",
+		"Content-Length: 72",
+		"",
+		`{"path":"/v1","host":"echo","method":"GET","namespace":"mesh","pod":"p"}`,
+	}, "\r\n")
+
+	t.Run("echo response", func(t *testing.T) {
+		cReq, cRes, err := parseCurlOutput([]byte(echoResponse), "")
+		require.NoError(t, err)
+		require.Equal(t, &CapturedRequest{Path: "/v1", Host: "echo", Method: "GET", Namespace: "mesh", Pod: "p"}, cReq)
+		require.Equal(t, 200, cRes.StatusCode)
+		require.Equal(t, "HTTP/1.1", cRes.Protocol)
+		require.Equal(t, []string{"value"}, cRes.Headers["X-Custom"])
+	})
+
+	t.Run("followed redirect", func(t *testing.T) {
+		redirect := "HTTP/1.1 302 Found\r\nLocation: http://echo/v1\r\nContent-Length: 0\r\n\r\n"
+		cReq, cRes, err := parseCurlOutput([]byte(redirect+echoResponse), "GET")
+		require.NoError(t, err)
+		require.Equal(t, 200, cRes.StatusCode)
+		require.Equal(t, "/v1", cReq.Path)
+	})
+
+	t.Run("unfollowed redirect", func(t *testing.T) {
+		redirect := "HTTP/2 301 \r\nlocation: https://echo:8443/v2\r\ncontent-length: 0\r\n\r\n"
+		_, cRes, err := parseCurlOutput([]byte(redirect), "GET")
+		require.NoError(t, err)
+		require.Equal(t, 301, cRes.StatusCode)
+		require.Equal(t, "HTTP/2.0", cRes.Protocol)
+		require.Equal(t, &RedirectRequest{Scheme: "https", Host: "echo", Port: "8443", Path: "/v2"}, cRes.RedirectRequest)
+	})
+
+	t.Run("head request", func(t *testing.T) {
+		head := "HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\nContent-Length: 10\r\n\r\n"
+		_, cRes, err := parseCurlOutput([]byte(head), "HEAD")
+		require.NoError(t, err)
+		require.Equal(t, 200, cRes.StatusCode)
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		_, _, err := parseCurlOutput([]byte("curl: (6) Could not resolve host: echo\n"), "GET")
+		require.Error(t, err)
+	})
+}
+
+func TestCurlBatchCommand(t *testing.T) {
+	request := Request{URL: url.URL{Scheme: "http", Host: "echo", Path: "/v1"}}
+	command, err := curlBatchCommand(request, time.Second, 3)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"curl", "--silent", "--show-error", "--include", "--max-time", "1", "--http1.1", "--location",
+		"--write-out", "\n" + curlBatchSeparator + "\n",
+		"http://echo/v1", "http://echo/v1", "http://echo/v1",
+	}, command)
+
+	request.Body = []byte("hello")
+	_, err = curlBatchCommand(request, time.Second, 3)
+	require.ErrorContains(t, err, "cannot be made in a batch")
+}
+
+func TestSplitCurlBatchOutput(t *testing.T) {
+	response := func(pod string) string {
+		body := `{"path":"/v1","pod":"` + pod + `"}`
+		return "HTTP/1.1 200 OK\r\nContent-Type: application/json\r\nContent-Length: " + strconv.Itoa(len(body)) + "\r\n\r\n" + body
+	}
+	separator := "\n" + curlBatchSeparator + "\n"
+	output := response("v1-abc") + separator + response("v2-abc") + separator
+
+	outputs, err := splitCurlBatchOutput([]byte(output), 2)
+	require.NoError(t, err)
+	require.Len(t, outputs, 2)
+	for i, pod := range []string{"v1-abc", "v2-abc"} {
+		cReq, cRes, err := parseCurlOutput(outputs[i], "GET")
+		require.NoError(t, err)
+		require.Equal(t, 200, cRes.StatusCode)
+		require.Equal(t, pod, cReq.Pod)
+	}
+
+	_, err = splitCurlBatchOutput([]byte(output), 3)
+	require.ErrorContains(t, err, "expected the output of 3 requests, got 2")
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"sync"
+	"time"
+)
+
+// RecordedRoundTrip is a request made by a RoundTripper, along with what was
+// captured from its response.
+type RecordedRoundTrip struct {
+	Time        time.Time           `json:"time"`
+	URL         string              `json:"url"`
+	Host        string              `json:"host,omitempty"`
+	Method      string              `json:"method,omitempty"`
+	Headers     map[string][]string `json:"headers,omitempty"`
+	Body        string              `json:"body,omitempty"`
+	HTTPVersion HTTPVersion         `json:"httpVersion,omitempty"`
+
+	CapturedRequest  *CapturedRequest  `json:"capturedRequest,omitempty"`
+	CapturedResponse *RecordedResponse `json:"capturedResponse,omitempty"`
+	Error            string            `json:"error,omitempty"`
+}
+
+// RecordedResponse is the part of a CapturedResponse kept in a
+// RecordedRoundTrip.
+type RecordedResponse struct {
+	StatusCode         int                 `json:"statusCode"`
+	Protocol           string              `json:"protocol,omitempty"`
+	NegotiatedProtocol string              `json:"negotiatedProtocol,omitempty"`
+	Headers            map[string][]string `json:"headers,omitempty"`
+	RedirectRequest    *RedirectRequest    `json:"redirectRequest,omitempty"`
+}
+
+// History keeps the most recent round trips made by a RoundTripper, so that
+// they can be inspected once a test has failed. It is safe for concurrent use.
+type History struct {
+	lock       sync.Mutex
+	size       int
+	next       int
+	roundTrips []RecordedRoundTrip
+}
+
+// NewHistory returns a History keeping the given number of round trips.
+func NewHistory(size int) *History {
+	return &History{size: size}
+}
+
+// Record adds a round trip to the history, replacing the oldest one if the
+// history is full.
+func (h *History) Record(roundTrip RecordedRoundTrip) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.size <= 0 {
+		return
+	}
+	if len(h.roundTrips) < h.size {
+		h.roundTrips = append(h.roundTrips, roundTrip)
+		return
+	}
+	h.roundTrips[h.next] = roundTrip
+	h.next = (h.next + 1) % h.size
+}
+
+// Since returns the round trips of the history made at or after the given
+// time, oldest first.
+func (h *History) Since(start time.Time) []RecordedRoundTrip {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	var roundTrips []RecordedRoundTrip
+	for i := range h.roundTrips {
+		roundTrip := h.roundTrips[(h.next+i)%len(h.roundTrips)]
+		if !roundTrip.Time.Before(start) {
+			roundTrips = append(roundTrips, roundTrip)
+		}
+	}
+	return roundTrips
+}
+
+// recordRoundTrip returns the RecordedRoundTrip of a request made at the given
+// time. Keys are never recorded.
+func recordRoundTrip(start time.Time, request Request, cReq *CapturedRequest, cRes *CapturedResponse, err error) RecordedRoundTrip {
+	roundTrip := RecordedRoundTrip{
+		Time:            start,
+		URL:             request.URL.String(),
+		Host:            request.Host,
+		Method:          request.Method,
+		Headers:         request.Headers,
+		Body:            string(request.Body),
+		HTTPVersion:     request.HTTPVersion,
+		CapturedRequest: cReq,
+	}
+	if cRes != nil {
+		roundTrip.CapturedResponse = &RecordedResponse{
+			StatusCode:         cRes.StatusCode,
+			Protocol:           cRes.Protocol,
+			NegotiatedProtocol: cRes.NegotiatedProtocol,
+			Headers:            cRes.Headers,
+			RedirectRequest:    cRes.RedirectRequest,
+		}
+	}
+	if err != nil {
+		roundTrip.Error = err.Error()
+	}
+	return roundTrip
+}
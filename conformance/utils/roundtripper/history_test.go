@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	start := time.Now()
+	history := NewHistory(3)
+	for i := 0; i < 5; i++ {
+		history.Record(RecordedRoundTrip{Time: start.Add(time.Duration(i) * time.Second), URL: string(rune('a' + i))})
+	}
+
+	urls := func(roundTrips []RecordedRoundTrip) []string {
+		var urls []string
+		for _, roundTrip := range roundTrips {
+			urls = append(urls, roundTrip.URL)
+		}
+		return urls
+	}
+	require.Equal(t, []string{"c", "d", "e"}, urls(history.Since(start)))
+	require.Equal(t, []string{"d", "e"}, urls(history.Since(start.Add(3*time.Second))))
+	require.Empty(t, history.Since(start.Add(time.Minute)))
+}
+
+func TestRecordRoundTrip(t *testing.T) {
+	start := time.Now()
+	request := Request{
+		URL:     url.URL{Scheme: "https", Host: "192.0.2.1", Path: "/"},
+		Host:    "example.com",
+		Body:    []byte("body"),
+		KeyPem:  []byte("key"),
+		Headers: map[string][]string{"X-Test": {"a", "b"}},
+	}
+
+	roundTrip := recordRoundTrip(start, request, nil, &CapturedResponse{StatusCode: 200, NegotiatedProtocol: "h2"}, nil)
+	require.Equal(t, RecordedRoundTrip{
+		Time:             start,
+		URL:              "https://192.0.2.1/",
+		Host:             "example.com",
+		Headers:          map[string][]string{"X-Test": {"a", "b"}},
+		Body:             "body",
+		CapturedResponse: &RecordedResponse{StatusCode: 200, NegotiatedProtocol: "h2"},
+	}, roundTrip)
+
+	roundTrip = recordRoundTrip(start, request, nil, nil, errors.New("connection refused"))
+	require.Equal(t, "connection refused", roundTrip.Error)
+	require.Nil(t, roundTrip.CapturedResponse)
+}
@@ -17,16 +17,22 @@ limitations under the License.
 package roundtripper
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"regexp"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/websocket"
 
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
 )
@@ -37,17 +43,81 @@ type RoundTripper interface {
 	CaptureRoundTrip(Request) (*CapturedRequest, *CapturedResponse, error)
 }
 
+// StreamRoundTripper is an interface used to make raw TCP and UDP exchanges
+// within conformance tests. It is implemented by the DefaultRoundTripper and
+// can be implemented by custom RoundTrippers which need to be used for tests
+// of TCPRoutes and UDPRoutes.
+type StreamRoundTripper interface {
+	CaptureStreamRoundTrip(StreamRequest) (*CapturedStreamResponse, error)
+}
+
+// BatchRoundTripper is an interface used to make the same request several
+// times in a row within conformance tests, such as when sampling how traffic
+// is split between backends. It is implemented by the ExecRoundTripper, for
+// which the overhead of making each request separately is high.
+type BatchRoundTripper interface {
+	CaptureBatchRoundTrip(request Request, n int) ([]*CapturedRequest, []*CapturedResponse, error)
+}
+
+// HTTPVersion is the version of HTTP used to make a request.
+type HTTPVersion string
+
+const (
+	// HTTPVersionDefault uses HTTP/1.1, except for TLS requests made without
+	// a certificate and server name, which use HTTP/2 if the server supports
+	// it.
+	HTTPVersionDefault HTTPVersion = ""
+
+	// HTTPVersion1 uses HTTP/1.1, also for TLS requests.
+	HTTPVersion1 HTTPVersion = "HTTP/1.1"
+
+	// HTTPVersion2 uses HTTP/2 over TLS, negotiated with ALPN. Requests fail
+	// if the server doesn't support it.
+	HTTPVersion2 HTTPVersion = "HTTP/2"
+
+	// HTTPVersionH2C uses cleartext HTTP/2 with prior knowledge, i.e. without
+	// an upgrade from HTTP/1.1.
+	HTTPVersionH2C HTTPVersion = "h2c"
+)
+
+// UpgradeRoundTripper is an interface used to make WebSocket exchanges, over
+// connections upgraded from HTTP/1.1, within conformance tests. It is
+// implemented by the DefaultRoundTripper and can be implemented by custom
+// RoundTrippers which need to be used for tests of WebSocket traffic.
+type UpgradeRoundTripper interface {
+	CaptureUpgradeRoundTrip(UpgradeRequest) (*CapturedUpgradeResponse, error)
+}
+
 // Request is the primary input for making a request.
 type Request struct {
-	URL              url.URL
-	Host             string
-	Protocol         string
-	Method           string
-	Headers          map[string][]string
+	URL      url.URL
+	Host     string
+	Protocol string
+	Method   string
+	// Headers are sent with all their values.
+	Headers map[string][]string
+	// Body is sent as the body of the request, if not empty.
+	Body []byte
+	// HTTPVersion is the version of HTTP the request is made with.
+	HTTPVersion      HTTPVersion
 	UnfollowRedirect bool
-	CertPem          []byte
-	KeyPem           []byte
-	Server           string
+
+	// CACertPem is the PEM encoded bundle of CA certificates trusted to
+	// verify the certificate of the server. The system roots are trusted if
+	// neither it nor CertPem are set.
+	CACertPem []byte
+	// ClientCertPem and ClientKeyPem are the PEM encoded certificate and
+	// key presented to servers which request a client certificate.
+	ClientCertPem []byte
+	ClientKeyPem  []byte
+	// CertPem and KeyPem are a self-signed certificate and its key, used as
+	// the trusted CA when CACertPem is not set, and as the client certificate
+	// when ClientCertPem and ClientKeyPem are not set.
+	CertPem []byte
+	KeyPem  []byte
+	// Server is the server name sent with SNI, and verified against the
+	// certificate of the server. It defaults to the host of the URL.
+	Server string
 }
 
 // CapturedRequest contains request metadata captured from an echoserver
@@ -72,6 +142,57 @@ type RedirectRequest struct {
 	Path   string
 }
 
+// StreamRequest is the primary input for making a raw TCP or UDP exchange.
+type StreamRequest struct {
+	// Network is either "tcp" or "udp".
+	Network string
+	// Address is the host:port to connect to.
+	Address string
+	// Payload is sent once the connection is established. For TCP, the
+	// connection is half-closed after the payload is sent. For UDP, the
+	// payload is sent as a single datagram and a newline is sent if it is
+	// empty, as a datagram is required to get a response.
+	Payload []byte
+}
+
+// UpgradeRequest is the primary input for making a WebSocket exchange.
+type UpgradeRequest struct {
+	// URL is the address to connect to and the path of the handshake
+	// request. Its scheme is either "ws" or "wss".
+	URL url.URL
+	// Host overrides the host of the URL in the Host and Origin headers of
+	// the handshake request.
+	Host    string
+	Headers map[string][]string
+	// Messages are sent as text frames, one at a time, each followed by
+	// reading a single message in response.
+	Messages []string
+	// CACertPem, ClientCertPem, ClientKeyPem, CertPem, KeyPem and Server
+	// configure TLS for "wss" requests, like for the TLS requests of Request.
+	// The server name defaults to the host of the handshake request.
+	CACertPem     []byte
+	ClientCertPem []byte
+	ClientKeyPem  []byte
+	CertPem       []byte
+	KeyPem        []byte
+	Server        string
+}
+
+// CapturedUpgradeResponse contains the data received in a WebSocket exchange.
+type CapturedUpgradeResponse struct {
+	// Messages are the messages received in response to each of the
+	// messages sent.
+	Messages []string
+}
+
+// CapturedStreamResponse contains the data received in a raw TCP or UDP
+// exchange.
+type CapturedStreamResponse struct {
+	// Data is everything read until the server closed the connection for
+	// TCP, or the first datagram received for UDP.
+	Data []byte
+}
+
 // CapturedResponse contains response metadata.
 type CapturedResponse struct {
 	StatusCode      int
@@ -79,6 +200,15 @@ type CapturedResponse struct {
 	Protocol        string
 	Headers         map[string][]string
 	RedirectRequest *RedirectRequest
+
+	// NegotiatedProtocol is the application protocol negotiated with ALPN
+	// for TLS requests, e.g. "h2" or "http/1.1". It is empty for plaintext
+	// requests, and for TLS requests if the server didn't select a protocol.
+	NegotiatedProtocol string
+
+	// PeerCertificates is the certificate chain presented by the server,
+	// starting with the leaf certificate. It is empty for plaintext requests.
+	PeerCertificates []*x509.Certificate
 }
 
 // DefaultRoundTripper is the default implementation of a RoundTripper. It will
@@ -86,6 +216,9 @@ type CapturedResponse struct {
 type DefaultRoundTripper struct {
 	Debug         bool
 	TimeoutConfig config.TimeoutConfig
+
+	// History, when set, records the requests made with CaptureRoundTrip.
+	History *History
 }
 
 // CaptureRoundTrip makes a request with the provided parameters and returns the
@@ -93,43 +226,19 @@ type DefaultRoundTripper struct {
 // there is an error running the function but not if an HTTP error status code
 // is received.
 func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
-	cReq := &CapturedRequest{}
-	client := &http.Client{}
-
-	if request.UnfollowRedirect {
-		client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
-			return http.ErrUseLastResponse
-		}
+	start := time.Now()
+	cReq, cRes, err := d.captureRoundTrip(request)
+	if d.History != nil {
+		d.History.Record(recordRoundTrip(start, request, cReq, cRes, err))
 	}
+	return cReq, cRes, err
+}
 
-	// Setup TLS transport if there are CertPem, KeyPem, and Server in the request
-	if request.Server != "" && len(request.CertPem) != 0 && len(request.KeyPem) != 0 {
-		// Create a certificate from the provided cert and key
-		cert, err := tls.X509KeyPair(request.CertPem, request.KeyPem)
-		if err != nil {
-			return nil, nil, fmt.Errorf("unexpected error creating cert: %w", err)
-		}
-
-		// Add the provided cert as a trusted CA
-		certPool := x509.NewCertPool()
-		if !certPool.AppendCertsFromPEM(request.CertPem) {
-			return nil, nil, fmt.Errorf("unexpected error adding trusted CA: %w", err)
-		}
-
-		if request.Server == "" {
-			return nil, nil, fmt.Errorf("unexpected error, server name required for TLS")
-		}
-
-		// Create the Transport for this provided host, cert, and trusted CA
-		client.Transport = &http.Transport{
-			// Disable G402: TLS MinVersion too low. (gosec)
-			// #nosec G402
-			TLSClientConfig: &tls.Config{
-				Certificates: []tls.Certificate{cert},
-				ServerName:   request.Server,
-				RootCAs:      certPool,
-			},
-		}
+func (d *DefaultRoundTripper) captureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	cReq := &CapturedRequest{}
+	client, err := d.httpClient(request)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	method := "GET"
@@ -138,7 +247,11 @@ func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedReques
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), d.TimeoutConfig.RequestTimeout)
 	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, method, request.URL.String(), nil)
+	var reqBody io.Reader
+	if len(request.Body) > 0 {
+		reqBody = bytes.NewReader(request.Body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, request.URL.String(), reqBody)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -147,9 +260,9 @@ func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedReques
 		req.Host = request.Host
 	}
 
-	if request.Headers != nil {
-		for name, value := range request.Headers {
-			req.Header.Set(name, value[0])
+	for name, values := range request.Headers {
+		for _, value := range values {
+			req.Header.Add(name, value)
 		}
 	}
 
@@ -201,6 +314,11 @@ func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedReques
 		Headers:       resp.Header,
 	}
 
+	if resp.TLS != nil {
+		cRes.PeerCertificates = resp.TLS.PeerCertificates
+		cRes.NegotiatedProtocol = resp.TLS.NegotiatedProtocol
+	}
+
 	if IsRedirect(resp.StatusCode) {
 		redirectURL, err := resp.Location()
 		if err != nil {
@@ -217,6 +335,261 @@ func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedReques
 	return cReq, cRes, nil
 }
 
+// httpClient returns a client making requests with the HTTP version and TLS
+// configuration of the request.
+func (d *DefaultRoundTripper) httpClient(request Request) (*http.Client, error) {
+	client := &http.Client{}
+
+	if request.UnfollowRedirect {
+		client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+
+	tlsConfig, err := tlsClientConfig(request)
+	if err != nil {
+		return nil, err
+	}
+
+	switch request.HTTPVersion {
+	case HTTPVersionDefault:
+		if tlsConfig != nil {
+			client.Transport = &http.Transport{
+				TLSClientConfig: tlsConfig,
+			}
+		}
+	case HTTPVersion1:
+		if tlsConfig != nil {
+			tlsConfig.NextProtos = []string{"http/1.1"}
+		}
+		client.Transport = &http.Transport{
+			TLSClientConfig: tlsConfig,
+			// A non-nil empty map disables HTTP/2.
+			TLSNextProto: map[string]func(string, *tls.Conn) http.RoundTripper{},
+		}
+	case HTTPVersion2:
+		client.Transport = &http2.Transport{
+			TLSClientConfig: tlsConfig,
+		}
+	case HTTPVersionH2C:
+		client.Transport = &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var dialer net.Dialer
+				return dialer.DialContext(ctx, network, addr)
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported HTTP version %q", request.HTTPVersion)
+	}
+
+	return client, nil
+}
+
+// tlsClientConfig returns the TLS configuration for the request, or nil if
+// neither a server name, trusted CA nor client certificate is provided.
+func tlsClientConfig(request Request) (*tls.Config, error) {
+	caCertPem := request.CACertPem
+	if len(caCertPem) == 0 {
+		caCertPem = request.CertPem
+	}
+	clientCertPem, clientKeyPem := request.ClientCertPem, request.ClientKeyPem
+	if len(clientCertPem) == 0 && len(clientKeyPem) == 0 {
+		clientCertPem, clientKeyPem = request.CertPem, request.KeyPem
+	}
+
+	if request.Server == "" && len(caCertPem) == 0 && len(clientCertPem) == 0 && len(clientKeyPem) == 0 {
+		return nil, nil
+	}
+
+	// Disable G402: TLS MinVersion too low. (gosec)
+	// #nosec G402
+	tlsConfig := &tls.Config{
+		ServerName: request.Server,
+	}
+
+	if len(caCertPem) != 0 {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCertPem) {
+			return nil, fmt.Errorf("unexpected error adding trusted CA: no valid PEM encoded certificate found")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	if len(clientCertPem) != 0 || len(clientKeyPem) != 0 {
+		cert, err := tls.X509KeyPair(clientCertPem, clientKeyPem)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected error creating client cert: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// CaptureStreamRoundTrip connects to the address of the request, sends the
+// payload and returns the data received in response. An error is returned if
+// the connection fails or no response is received before the request timeout.
+func (d *DefaultRoundTripper) CaptureStreamRoundTrip(request StreamRequest) (*CapturedStreamResponse, error) {
+	payload := request.Payload
+	switch request.Network {
+	case "tcp":
+	case "udp":
+		if len(payload) == 0 {
+			payload = []byte("\n")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported network %q, must be either tcp or udp", request.Network)
+	}
+
+	conn, err := net.DialTimeout(request.Network, request.Address, d.TimeoutConfig.RequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(d.TimeoutConfig.RequestTimeout)); err != nil {
+		return nil, err
+	}
+
+	if d.Debug {
+		fmt.Printf("Sending %s payload to %s:\n%s\n\n", request.Network, request.Address, formatDump(payload, "< "))
+	}
+
+	if len(payload) > 0 {
+		if _, err := conn.Write(payload); err != nil {
+			return nil, fmt.Errorf("unexpected error writing payload: %w", err)
+		}
+	}
+
+	var data []byte
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		if len(payload) > 0 {
+			if err := tcpConn.CloseWrite(); err != nil {
+				return nil, fmt.Errorf("unexpected error closing connection for writing: %w", err)
+			}
+		}
+		data, err = io.ReadAll(tcpConn)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected error reading response: %w", err)
+		}
+	} else {
+		buf := make([]byte, 64*1024)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected error reading response: %w", err)
+		}
+		data = buf[:n]
+	}
+
+	if d.Debug {
+		fmt.Printf("Received %s response from %s:\n%s\n\n", request.Network, request.Address, formatDump(data, "< "))
+	}
+
+	return &CapturedStreamResponse{Data: data}, nil
+}
+
+// CaptureUpgradeRoundTrip performs a WebSocket handshake with the URL of the
+// request, then sends each of its messages and reads a message in response to
+// each of them. An error is returned if the handshake is rejected, e.g. because
+// the connection isn't upgraded, or if any message can't be exchanged before
+// the request timeout.
+func (d *DefaultRoundTripper) CaptureUpgradeRoundTrip(request UpgradeRequest) (*CapturedUpgradeResponse, error) {
+	location := request.URL
+	if request.Host != "" {
+		location.Host = request.Host
+	}
+	origin := url.URL{Scheme: "http", Host: location.Host}
+	switch request.URL.Scheme {
+	case "ws":
+	case "wss":
+		origin.Scheme = "https"
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q, must be either ws or wss", request.URL.Scheme)
+	}
+
+	wsConfig, err := websocket.NewConfig(location.String(), origin.String())
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range request.Headers {
+		for _, value := range values {
+			wsConfig.Header.Add(name, value)
+		}
+	}
+
+	address := request.URL.Host
+	if request.URL.Port() == "" {
+		port := "80"
+		if request.URL.Scheme == "wss" {
+			port = "443"
+		}
+		address = net.JoinHostPort(request.URL.Hostname(), port)
+	}
+	conn, err := net.DialTimeout("tcp", address, d.TimeoutConfig.RequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(d.TimeoutConfig.RequestTimeout)); err != nil {
+		return nil, err
+	}
+
+	if request.URL.Scheme == "wss" {
+		tlsConfig, err := tlsClientConfig(Request{
+			CACertPem:     request.CACertPem,
+			ClientCertPem: request.ClientCertPem,
+			ClientKeyPem:  request.ClientKeyPem,
+			CertPem:       request.CertPem,
+			KeyPem:        request.KeyPem,
+			Server:        request.Server,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig == nil {
+			// #nosec G402
+			tlsConfig = &tls.Config{}
+		}
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = location.Hostname()
+		}
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	if d.Debug {
+		fmt.Printf("Sending WebSocket handshake to %s for %s\n\n", address, location.String())
+	}
+
+	ws, err := websocket.NewClient(wsConfig, conn)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error in WebSocket handshake: %w", err)
+	}
+	defer ws.Close()
+
+	cRes := &CapturedUpgradeResponse{}
+	for _, message := range request.Messages {
+		if d.Debug {
+			fmt.Printf("Sending WebSocket message:\n%s\n\n", formatDump([]byte(message), "< "))
+		}
+		if err := websocket.Message.Send(ws, message); err != nil {
+			return nil, fmt.Errorf("unexpected error sending WebSocket message: %w", err)
+		}
+
+		var received string
+		if err := websocket.Message.Receive(ws, &received); err != nil {
+			return nil, fmt.Errorf("unexpected error receiving WebSocket message: %w", err)
+		}
+		if d.Debug {
+			fmt.Printf("Received WebSocket message:\n%s\n\n", formatDump([]byte(received), "< "))
+		}
+		cRes.Messages = append(cRes.Messages, received)
+	}
+
+	return cRes, nil
+}
+
 // IsRedirect returns true if a given status code is a redirect code.
 func IsRedirect(statusCode int) bool {
 	switch statusCode {
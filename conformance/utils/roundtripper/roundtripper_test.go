@@ -0,0 +1,366 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roundtripper
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"golang.org/x/net/websocket"
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+)
+
+func TestCaptureStreamRoundTripTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		payload, _ := io.ReadAll(conn)
+		_, _ = conn.Write(append([]byte("echo: "), payload...))
+	}()
+
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	res, err := rt.CaptureStreamRoundTrip(StreamRequest{Network: "tcp", Address: listener.Addr().String(), Payload: []byte("hello")})
+	require.NoError(t, err)
+	require.Equal(t, "echo: hello", string(res.Data))
+}
+
+func TestCaptureStreamRoundTripUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	go func() {
+		buf := make([]byte, 1024)
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		_, _ = conn.WriteTo(append([]byte("echo: "), buf[:n]...), addr)
+	}()
+
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	res, err := rt.CaptureStreamRoundTrip(StreamRequest{Network: "udp", Address: conn.LocalAddr().String()})
+	require.NoError(t, err)
+	require.Equal(t, "echo: \n", string(res.Data))
+}
+
+func TestCaptureStreamRoundTripUnsupportedNetwork(t *testing.T) {
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: time.Second}}
+	_, err := rt.CaptureStreamRoundTrip(StreamRequest{Network: "sctp", Address: "127.0.0.1:1"})
+	require.Error(t, err)
+}
+
+func TestCaptureRoundTripPeerCertificates(t *testing.T) {
+	secret := kubernetes.MustCreateSelfSignedCertSecret(t, "ns", "certificate", []string{"example.com"})
+	certPem, keyPem := secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]
+	cert, err := tls.X509KeyPair(certPem, keyPem)
+	require.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+	server.StartTLS()
+	defer server.Close()
+
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	_, cRes, err := rt.CaptureRoundTrip(Request{URL: *serverURL, CertPem: certPem, KeyPem: keyPem, Server: "example.com"})
+	require.NoError(t, err)
+	require.Len(t, cRes.PeerCertificates, 1)
+	require.Equal(t, []string{"example.com"}, cRes.PeerCertificates[0].DNSNames)
+
+	_, _, err = rt.CaptureRoundTrip(Request{URL: *serverURL, CertPem: certPem, KeyPem: keyPem, Server: "other.example.com"})
+	require.Error(t, err, "the certificate must not be valid for other hostnames")
+
+	plainServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer plainServer.Close()
+	plainURL, err := url.Parse(plainServer.URL)
+	require.NoError(t, err)
+	_, cRes, err = rt.CaptureRoundTrip(Request{URL: *plainURL})
+	require.NoError(t, err)
+	require.Empty(t, cRes.PeerCertificates)
+}
+
+// echoHandler responds with the captured request as JSON, like the
+// echoserver, and echoes the request body in the X-Request-Body header.
+var echoHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("X-Request-Body", string(body))
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(CapturedRequest{
+		Path:     r.URL.Path,
+		Host:     r.Host,
+		Method:   r.Method,
+		Protocol: r.Proto,
+		Headers:  r.Header,
+	})
+})
+
+func TestCaptureRoundTripBodyAndHeaders(t *testing.T) {
+	server := httptest.NewServer(echoHandler)
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	cReq, cRes, err := rt.CaptureRoundTrip(Request{
+		URL:     *serverURL,
+		Method:  "POST",
+		Headers: map[string][]string{"X-Multi": {"one", "two"}},
+		Body:    []byte(`{"hello":"world"}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "POST", cReq.Method)
+	require.Equal(t, []string{"one", "two"}, cReq.Headers["X-Multi"])
+	require.Equal(t, []string{`{"hello":"world"}`}, cRes.Headers["X-Request-Body"])
+}
+
+func TestCaptureRoundTripHTTPVersions(t *testing.T) {
+	secret := kubernetes.MustCreateSelfSignedCertSecret(t, "ns", "certificate", []string{"example.com"})
+	certPem, keyPem := secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]
+	cert, err := tls.X509KeyPair(certPem, keyPem)
+	require.NoError(t, err)
+
+	tlsServer := httptest.NewUnstartedServer(echoHandler)
+	tlsServer.EnableHTTP2 = true
+	tlsServer.TLS = &tls.Config{Certificates: []tls.Certificate{cert}, NextProtos: []string{"h2", "http/1.1"}}
+	tlsServer.StartTLS()
+	defer tlsServer.Close()
+	tlsURL, err := url.Parse(tlsServer.URL)
+	require.NoError(t, err)
+
+	h2cServer := httptest.NewServer(h2c.NewHandler(echoHandler, &http2.Server{}))
+	defer h2cServer.Close()
+	h2cURL, err := url.Parse(h2cServer.URL)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name                   string
+		request                Request
+		wantProtocol           string
+		wantNegotiatedProtocol string
+	}{{
+		name:         "default plaintext",
+		request:      Request{URL: *h2cURL},
+		wantProtocol: "HTTP/1.1",
+	}, {
+		name:                   "HTTP/1.1 over TLS",
+		request:                Request{URL: *tlsURL, CertPem: certPem, KeyPem: keyPem, Server: "example.com", HTTPVersion: HTTPVersion1},
+		wantProtocol:           "HTTP/1.1",
+		wantNegotiatedProtocol: "http/1.1",
+	}, {
+		name:                   "HTTP/2 over TLS",
+		request:                Request{URL: *tlsURL, CertPem: certPem, KeyPem: keyPem, Server: "example.com", HTTPVersion: HTTPVersion2},
+		wantProtocol:           "HTTP/2.0",
+		wantNegotiatedProtocol: "h2",
+	}, {
+		name:         "h2c",
+		request:      Request{URL: *h2cURL, HTTPVersion: HTTPVersionH2C, Method: "POST", Body: []byte("hello")},
+		wantProtocol: "HTTP/2.0",
+	}}
+
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cReq, cRes, err := rt.CaptureRoundTrip(tc.request)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantProtocol, cReq.Protocol, "protocol of the request received by the server")
+			require.Equal(t, tc.wantProtocol, cRes.Protocol)
+			require.Equal(t, tc.wantNegotiatedProtocol, cRes.NegotiatedProtocol)
+			require.Equal(t, []string{string(tc.request.Body)}, cRes.Headers["X-Request-Body"])
+		})
+	}
+
+	_, _, err = rt.CaptureRoundTrip(Request{URL: *h2cURL, HTTPVersion: "HTTP/3"})
+	require.EqualError(t, err, `unsupported HTTP version "HTTP/3"`)
+}
+
+func TestCaptureUpgradeRoundTrip(t *testing.T) {
+	var hosts []string
+	mux := http.NewServeMux()
+	mux.Handle("/ws", websocket.Handler(func(ws *websocket.Conn) {
+		hosts = append(hosts, ws.Request().Host)
+		_, _ = io.Copy(ws, ws)
+	}))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+
+	cRes, err := rt.CaptureUpgradeRoundTrip(UpgradeRequest{
+		URL:      url.URL{Scheme: "ws", Host: serverURL.Host, Path: "/ws"},
+		Host:     "websocket.example.com",
+		Messages: []string{"hello", "world"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"hello", "world"}, cRes.Messages)
+	require.Equal(t, []string{"websocket.example.com"}, hosts)
+
+	_, err = rt.CaptureUpgradeRoundTrip(UpgradeRequest{
+		URL:      url.URL{Scheme: "ws", Host: serverURL.Host, Path: "/not-upgraded"},
+		Messages: []string{"hello"},
+	})
+	require.Error(t, err, "the handshake must fail if the connection isn't upgraded")
+
+	_, err = rt.CaptureUpgradeRoundTrip(UpgradeRequest{URL: url.URL{Scheme: "http", Host: serverURL.Host}})
+	require.EqualError(t, err, `unsupported scheme "http", must be either ws or wss`)
+}
+
+func TestCaptureUpgradeRoundTripClientCertificates(t *testing.T) {
+	serverSecret := kubernetes.MustCreateSelfSignedCertSecret(t, "ns", "server", []string{"secure.example.com"})
+	serverCertPem, serverKeyPem := serverSecret.Data[corev1.TLSCertKey], serverSecret.Data[corev1.TLSPrivateKeyKey]
+	serverCert, err := tls.X509KeyPair(serverCertPem, serverKeyPem)
+	require.NoError(t, err)
+
+	clientSecret := kubernetes.MustCreateSelfSignedCertSecret(t, "ns", "client", []string{"client.example.com"})
+	clientCertPem, clientKeyPem := clientSecret.Data[corev1.TLSCertKey], clientSecret.Data[corev1.TLSPrivateKeyKey]
+	clientCert, err := tls.X509KeyPair(clientCertPem, clientKeyPem)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.Handle("/ws", websocket.Handler(func(ws *websocket.Conn) {
+		_, _ = io.Copy(ws, ws)
+	}))
+	server := httptest.NewUnstartedServer(mux)
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAnyClientCert,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) != 1 || !bytes.Equal(rawCerts[0], clientCert.Certificate[0]) {
+				return errors.New("unexpected client certificate")
+			}
+			return nil
+		},
+	}
+	server.StartTLS()
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+
+	cRes, err := rt.CaptureUpgradeRoundTrip(UpgradeRequest{
+		URL:           url.URL{Scheme: "wss", Host: serverURL.Host, Path: "/ws"},
+		Messages:      []string{"hello"},
+		CACertPem:     serverCertPem,
+		ClientCertPem: clientCertPem,
+		ClientKeyPem:  clientKeyPem,
+		Server:        "secure.example.com",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"hello"}, cRes.Messages)
+
+	_, err = rt.CaptureUpgradeRoundTrip(UpgradeRequest{
+		URL:       url.URL{Scheme: "wss", Host: serverURL.Host, Path: "/ws"},
+		Messages:  []string{"hello"},
+		CACertPem: serverCertPem,
+		Server:    "secure.example.com",
+	})
+	require.Error(t, err, "the server must reject handshakes without a client certificate")
+}
+
+func TestCaptureRoundTripClientCertificates(t *testing.T) {
+	serverSecret := kubernetes.MustCreateSelfSignedCertSecret(t, "ns", "server", []string{"secure.example.com"})
+	serverCertPem, serverKeyPem := serverSecret.Data[corev1.TLSCertKey], serverSecret.Data[corev1.TLSPrivateKeyKey]
+	serverCert, err := tls.X509KeyPair(serverCertPem, serverKeyPem)
+	require.NoError(t, err)
+
+	clientSecret := kubernetes.MustCreateSelfSignedCertSecret(t, "ns", "client", []string{"client.example.com"})
+	clientCertPem, clientKeyPem := clientSecret.Data[corev1.TLSCertKey], clientSecret.Data[corev1.TLSPrivateKeyKey]
+	clientCert, err := tls.X509KeyPair(clientCertPem, clientKeyPem)
+	require.NoError(t, err)
+
+	// The self-signed certificates are only valid for server authentication,
+	// so the client certificate is compared instead of verified.
+	server := httptest.NewUnstartedServer(echoHandler)
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAnyClientCert,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) != 1 || !bytes.Equal(rawCerts[0], clientCert.Certificate[0]) {
+				return errors.New("unexpected client certificate")
+			}
+			return nil
+		},
+	}
+	server.StartTLS()
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	rt := &DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+
+	_, cRes, err := rt.CaptureRoundTrip(Request{
+		URL:           *serverURL,
+		CACertPem:     serverCertPem,
+		ClientCertPem: clientCertPem,
+		ClientKeyPem:  clientKeyPem,
+		Server:        "secure.example.com",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 200, cRes.StatusCode)
+	require.Len(t, cRes.PeerCertificates, 1)
+	require.Equal(t, []string{"secure.example.com"}, cRes.PeerCertificates[0].DNSNames)
+
+	_, _, err = rt.CaptureRoundTrip(Request{
+		URL:       *serverURL,
+		CACertPem: serverCertPem,
+		Server:    "secure.example.com",
+	})
+	require.Error(t, err, "the server must reject requests without a client certificate")
+
+	_, _, err = rt.CaptureRoundTrip(Request{
+		URL:       *serverURL,
+		CACertPem: []byte("not a certificate"),
+		Server:    "secure.example.com",
+	})
+	require.EqualError(t, err, "unexpected error adding trusted CA: no valid PEM encoded certificate found")
+
+	_, _, err = rt.CaptureRoundTrip(Request{
+		URL:           *serverURL,
+		CACertPem:     serverCertPem,
+		ClientCertPem: clientCertPem,
+		Server:        "secure.example.com",
+	})
+	require.ErrorContains(t, err, "unexpected error creating client cert")
+}
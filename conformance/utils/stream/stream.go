@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package stream contains helpers to make raw TCP and UDP requests to
+// Gateways, e.g. for TCPRoute and UDPRoute conformance tests. The stream
+// backends of these tests respond to every connection, or datagram, with the
+// name of the Pod that received it.
+package stream
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+const (
+	// TCP is the network used for TCPRoute requests.
+	TCP = "tcp"
+
+	// UDP is the network used for UDPRoute requests.
+	UDP = "udp"
+)
+
+// ExpectedResponse defines the backend a raw TCP or UDP request is expected to
+// reach.
+type ExpectedResponse struct {
+	// Backend is the prefix of the name of the Pod expected to respond.
+	Backend string
+}
+
+// MakeRequestAndExpectEventuallyConsistentResponse makes a request over the
+// given network to the Gateway address, understanding that the request may
+// fail for some amount of time.
+//
+// Once the request succeeds consistently with the response coming from the
+// expected backend, the function returns.
+func MakeRequestAndExpectEventuallyConsistentResponse(t *testing.T, r roundtripper.RoundTripper, timeoutConfig config.TimeoutConfig, network, gwAddr string, expected ExpectedResponse) {
+	t.Helper()
+
+	srt := mustBeStreamRoundTripper(t, r)
+	req := roundtripper.StreamRequest{Network: network, Address: gwAddr}
+	t.Logf("Making %s request to %s", network, gwAddr)
+
+	http.AwaitConvergenceWithTimeoutConfig(t, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig, func(elapsed time.Duration) bool {
+		pod, err := captureStreamRoundTrip(srt, req)
+		if err != nil {
+			t.Logf("Request failed, not ready yet: %v (after %v)", err, elapsed)
+			return false
+		}
+		if !strings.HasPrefix(pod, expected.Backend) {
+			t.Logf("Response expectation failed for %s request to %s: expected pod name to start with %s, got %s, not ready yet (after %v)", network, gwAddr, expected.Backend, pod, elapsed)
+			return false
+		}
+		return true
+	})
+	t.Logf("Request passed")
+}
+
+// MakeRequestsAndExpectWeightedBackends waits for requests over the given
+// network to the Gateway address to succeed consistently, then repeatedly
+// sends batches of requests until the observed distribution of responses
+// across backends is within the configured tolerance of the expected weights.
+// The test fails immediately if any of the requests reaches a backend with a
+// weight of zero.
+func MakeRequestsAndExpectWeightedBackends(t *testing.T, r roundtripper.RoundTripper, timeoutConfig config.TimeoutConfig, network, gwAddr string, backends http.WeightedBackends) {
+	t.Helper()
+
+	backends = backends.WithDefaults()
+	srt := mustBeStreamRoundTripper(t, r)
+	req := roundtripper.StreamRequest{Network: network, Address: gwAddr}
+	MakeRequestAndExpectEventuallyConsistentResponse(t, r, timeoutConfig, network, gwAddr, ExpectedResponse{})
+
+	t.Logf("Making %s requests to %s, expecting responses weighted as %v", network, gwAddr, backends.Weights)
+	http.AwaitConvergenceWithTimeoutConfig(t, 1, timeoutConfig, func(elapsed time.Duration) bool {
+		counts := map[string]int{}
+		for i := 0; i < backends.Requests; i++ {
+			pod, err := captureStreamRoundTrip(srt, req)
+			if err != nil {
+				t.Logf("Request failed, not ready yet: %v (after %v)", err, elapsed)
+				return false
+			}
+			backend := http.BackendForPod(pod, backends.Weights)
+			http.BackendMustNotHaveZeroWeight(t, backend, backends.Weights)
+			counts[backend]++
+		}
+
+		if err := http.CompareDistribution(counts, backends.Weights, backends.Requests, backends.Tolerance); err != nil {
+			t.Logf("Traffic distribution not as expected: %v (after %v)", err, elapsed)
+			return false
+		}
+		t.Logf("Observed traffic distribution %v over %d requests", counts, backends.Requests)
+		return true
+	})
+	t.Logf("Requests passed")
+}
+
+// mustBeStreamRoundTripper fails the test if the RoundTripper cannot make raw
+// TCP and UDP requests.
+func mustBeStreamRoundTripper(t *testing.T, r roundtripper.RoundTripper) roundtripper.StreamRoundTripper {
+	t.Helper()
+
+	srt, ok := r.(roundtripper.StreamRoundTripper)
+	if !ok {
+		t.Fatalf("RoundTripper %T does not implement roundtripper.StreamRoundTripper", r)
+	}
+	return srt
+}
+
+// captureStreamRoundTrip makes the request and returns the name of the Pod
+// which responded.
+func captureStreamRoundTrip(r roundtripper.StreamRoundTripper, req roundtripper.StreamRequest) (string, error) {
+	res, err := r.CaptureStreamRoundTrip(req)
+	if err != nil {
+		return "", err
+	}
+	pod := strings.TrimSpace(string(res.Data))
+	if pod == "" {
+		return "", fmt.Errorf("empty response")
+	}
+	return pod, nil
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// DefaultArtifactsRoundTrips is the default number of round trips kept to be
+// written to the artifacts of failed tests.
+const DefaultArtifactsRoundTrips = 50
+
+// artifactsNamespacePrefix is the prefix of the namespaces whose objects and
+// Events are collected for failed tests.
+const artifactsNamespacePrefix = "gateway-conformance"
+
+// artifactKinds are the kinds of the Gateway API objects collected for failed
+// tests.
+var artifactKinds = []schema.GroupVersionKind{
+	v1beta1.SchemeGroupVersion.WithKind("GatewayClass"),
+	v1beta1.SchemeGroupVersion.WithKind("Gateway"),
+	v1beta1.SchemeGroupVersion.WithKind("HTTPRoute"),
+	v1beta1.SchemeGroupVersion.WithKind("ReferenceGrant"),
+	v1alpha2.SchemeGroupVersion.WithKind("GRPCRoute"),
+	v1alpha2.SchemeGroupVersion.WithKind("TLSRoute"),
+	v1alpha2.SchemeGroupVersion.WithKind("TCPRoute"),
+	v1alpha2.SchemeGroupVersion.WithKind("UDPRoute"),
+}
+
+// artifactCollector collects the artifacts of a test at most once, when the
+// test has failed.
+type artifactCollector struct {
+	t     *testing.T
+	test  *ConformanceTest
+	start time.Time
+	once  sync.Once
+}
+
+// registerArtifactCollector registers the artifact collector of the test run
+// with t. The collector runs in a cleanup function, registered before any of
+// the resources of the test are applied, so that it runs once the test and
+// its subtests have completed, if the artifacts were not collected earlier
+// by collectArtifactsBeforeDelete.
+func (suite *ConformanceTestSuite) registerArtifactCollector(t *testing.T, test *ConformanceTest) {
+	collector := &artifactCollector{t: t, test: test, start: time.Now()}
+
+	suite.artifactCollectorsLock.Lock()
+	suite.artifactCollectors[t.Name()] = collector
+	suite.artifactCollectorsLock.Unlock()
+
+	t.Cleanup(func() {
+		suite.artifactCollectorsLock.Lock()
+		delete(suite.artifactCollectors, t.Name())
+		suite.artifactCollectorsLock.Unlock()
+
+		suite.collectArtifactsOnFailure(collector)
+	})
+}
+
+// collectArtifactsBeforeDelete is called before a resource applied with t is
+// deleted, and collects the artifacts of the test t belongs to, if it has
+// failed, so that they include the resources the test applied itself.
+func (suite *ConformanceTestSuite) collectArtifactsBeforeDelete(t *testing.T) {
+	suite.artifactCollectorsLock.Lock()
+	var collector *artifactCollector
+	for name, c := range suite.artifactCollectors {
+		if t.Name() == name || strings.HasPrefix(t.Name(), name+"/") {
+			collector = c
+			break
+		}
+	}
+	suite.artifactCollectorsLock.Unlock()
+
+	if collector != nil {
+		suite.collectArtifactsOnFailure(collector)
+	}
+}
+
+// collectArtifactsOnFailure collects the artifacts of the test of the
+// collector if it has failed and they were not collected yet.
+func (suite *ConformanceTestSuite) collectArtifactsOnFailure(collector *artifactCollector) {
+	if !collector.t.Failed() {
+		return
+	}
+	collector.once.Do(func() {
+		suite.collectArtifacts(collector.t, collector.test, collector.start)
+	})
+}
+
+// collectArtifacts writes the Gateway API objects and Events of the
+// conformance namespaces, along with the round trips made since the test
+// started, to the artifacts directory of the test. Errors are logged rather
+// than failing the test, which has already failed.
+func (suite *ConformanceTestSuite) collectArtifacts(t *testing.T, test *ConformanceTest, start time.Time) {
+	dir := filepath.Join(suite.ArtifactsDir, test.ShortName)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Logf("Unable to create artifacts directory %s: %v", dir, err)
+		return
+	}
+	t.Logf("Writing artifacts of %s to %s", test.ShortName, dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.GetTimeout)
+	defer cancel()
+
+	namespaces, err := artifactsNamespaces(ctx, suite.Client)
+	if err != nil {
+		t.Logf("Unable to list namespaces for artifacts: %v", err)
+	}
+
+	objects, err := gatewayAPIObjects(ctx, suite.Client, namespaces)
+	if err != nil {
+		t.Logf("Unable to list Gateway API objects for artifacts: %v", err)
+	}
+	writeArtifact(t, filepath.Join(dir, "objects.yaml"), objects)
+
+	events, err := namespaceEvents(ctx, suite.Client, namespaces)
+	if err != nil {
+		t.Logf("Unable to list Events for artifacts: %v", err)
+	}
+	writeArtifact(t, filepath.Join(dir, "events.yaml"), events)
+
+	if suite.roundTripHistory != nil {
+		roundTrips := suite.roundTripHistory.Since(start)
+		items := make([]interface{}, 0, len(roundTrips))
+		for _, roundTrip := range roundTrips {
+			items = append(items, roundTrip)
+		}
+		writeArtifact(t, filepath.Join(dir, "round-trips.yaml"), items)
+	}
+}
+
+// artifactsNamespaces returns the names of the conformance namespaces.
+func artifactsNamespaces(ctx context.Context, c client.Client) ([]string, error) {
+	namespaceList := &corev1.NamespaceList{}
+	if err := c.List(ctx, namespaceList); err != nil {
+		return nil, err
+	}
+	var namespaces []string
+	for _, namespace := range namespaceList.Items {
+		if strings.HasPrefix(namespace.Name, artifactsNamespacePrefix) {
+			namespaces = append(namespaces, namespace.Name)
+		}
+	}
+	return namespaces, nil
+}
+
+// gatewayAPIObjects returns the GatewayClasses, and the other Gateway API
+// objects of the given namespaces. Kinds that are not installed in the
+// cluster are skipped.
+func gatewayAPIObjects(ctx context.Context, c client.Client, namespaces []string) ([]interface{}, error) {
+	var objects []interface{}
+	for _, gvk := range artifactKinds {
+		listNamespaces := namespaces
+		if gvk.Kind == "GatewayClass" {
+			listNamespaces = []string{""}
+		}
+		for _, namespace := range listNamespaces {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+				if meta.IsNoMatchError(err) {
+					break
+				}
+				return objects, err
+			}
+			for i := range list.Items {
+				list.Items[i].SetManagedFields(nil)
+				objects = append(objects, list.Items[i].Object)
+			}
+		}
+	}
+	return objects, nil
+}
+
+// namespaceEvents returns the Events of the given namespaces.
+func namespaceEvents(ctx context.Context, c client.Client, namespaces []string) ([]interface{}, error) {
+	var events []interface{}
+	for _, namespace := range namespaces {
+		eventList := &corev1.EventList{}
+		if err := c.List(ctx, eventList, client.InNamespace(namespace)); err != nil {
+			return events, err
+		}
+		for i := range eventList.Items {
+			event := eventList.Items[i]
+			event.ManagedFields = nil
+			event.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Event"))
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// writeArtifact writes the items to the file as a stream of YAML documents.
+func writeArtifact(t *testing.T, path string, items []interface{}) {
+	var buf bytes.Buffer
+	for _, item := range items {
+		b, err := yaml.Marshal(item)
+		if err != nil {
+			t.Logf("Unable to marshal artifact for %s: %v", path, err)
+			continue
+		}
+		fmt.Fprintf(&buf, "---\n%s", b)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Logf("Unable to write artifact %s: %v", path, err)
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+func TestCollectArtifacts(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	require.NoError(t, v1beta1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "gateway-conformance-infra"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+		&v1beta1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "gateway-conformance"}},
+		&v1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "same-namespace", Namespace: "gateway-conformance-infra"}},
+		&v1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kube-system"}},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "gateway-event", Namespace: "gateway-conformance-infra"}, Reason: "Programmed"},
+	).Build()
+
+	start := time.Now()
+	history := roundtripper.NewHistory(5)
+	history.Record(roundtripper.RecordedRoundTrip{Time: start.Add(-time.Second), URL: "http://192.0.2.1/before"})
+	history.Record(roundtripper.RecordedRoundTrip{Time: start, URL: "http://192.0.2.1/during"})
+
+	suite := &ConformanceTestSuite{
+		Client:           c,
+		TimeoutConfig:    config.DefaultTimeoutConfig(),
+		ArtifactsDir:     t.TempDir(),
+		roundTripHistory: history,
+	}
+	suite.collectArtifacts(t, &ConformanceTest{ShortName: "HTTPRouteSimpleSameNamespace"}, start)
+
+	read := func(name string) string {
+		b, err := os.ReadFile(filepath.Join(suite.ArtifactsDir, "HTTPRouteSimpleSameNamespace", name))
+		require.NoError(t, err)
+		return string(b)
+	}
+
+	objects := read("objects.yaml")
+	require.Contains(t, objects, "name: gateway-conformance\n")
+	require.Contains(t, objects, "name: same-namespace\n")
+	require.NotContains(t, objects, "name: other\n", "only the objects of the conformance namespaces must be collected")
+
+	events := read("events.yaml")
+	require.Contains(t, events, "reason: Programmed")
+
+	roundTrips := read("round-trips.yaml")
+	require.Contains(t, roundTrips, "/during")
+	require.NotContains(t, roundTrips, "/before", "only the round trips made during the test must be collected")
+}
+
+// applyClient creates resources applied with server-side apply, which the
+// fake client does not support.
+type applyClient struct {
+	client.Client
+}
+
+func (c applyClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if patch.Type() == types.ApplyPatchType {
+		return c.Client.Create(ctx, obj)
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func TestCollectArtifactsOfTestResources(t *testing.T) {
+	// The failing test would fail this test as well, so the suite is run in
+	// a separate process, which writes the artifacts to the given directory.
+	if dir := os.Getenv("GATEWAY_CONFORMANCE_ARTIFACTS_DIR"); dir != "" {
+		scheme := runtime.NewScheme()
+		require.NoError(t, clientgoscheme.AddToScheme(scheme))
+		require.NoError(t, v1beta1.AddToScheme(scheme))
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "gateway-conformance-infra"}},
+		).Build()
+
+		suite := New(Options{Client: applyClient{c}, ArtifactsDir: dir})
+		suite.Run(t, []ConformanceTest{{
+			ShortName: "TestResources",
+			Test: func(t *testing.T, s *ConformanceTestSuite) {
+				route := &v1beta1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "test-created-route", Namespace: "gateway-conformance-infra"}}
+				s.Applier.MustApplyObjectsWithCleanup(t, s.Client, s.TimeoutConfig, []client.Object{route}, true)
+				t.Error("test failed")
+			},
+		}})
+		return
+	}
+
+	dir := t.TempDir()
+	cmd := exec.Command(os.Args[0], "-test.run=^TestCollectArtifactsOfTestResources$")
+	cmd.Env = append(os.Environ(), "GATEWAY_CONFORMANCE_ARTIFACTS_DIR="+dir)
+	out, err := cmd.CombinedOutput()
+	require.Error(t, err, "the failing test must fail the run: %s", out)
+
+	objects, err := os.ReadFile(filepath.Join(dir, "TestResources", "objects.yaml"))
+	require.NoError(t, err, "artifacts must be written: %s", out)
+	require.Contains(t, string(objects), "name: test-created-route\n", "the resources created by the test must be collected before they are deleted")
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"io/fs"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Extension is a set of implementation-specific conformance tests, such as
+// tests of ExtensionRef filters or of backendRefs to kinds other than Service,
+// that are run by the suite alongside the upstream tests. The suite applies
+// and cleans up their manifests, skips them unless their features are
+// supported, and reports their results separately from the conformance
+// profiles, as they have no bearing on the conformance of an implementation.
+type Extension struct {
+	// Name identifies the extension, e.g. the name of the implementation.
+	Name string
+
+	// Features are the implementation-specific features exercised by the
+	// tests of the extension. Like upstream features, they must be listed in
+	// the SupportedFeatures of the suite for the tests to run, unless all
+	// features are enabled.
+	Features []SupportedFeature
+
+	// Tests are the tests of the extension. Their ShortNames must not collide
+	// with those of any other test run by the suite.
+	Tests []ConformanceTest
+
+	// Manifests is the filesystem the manifests of the extension are read
+	// from, for both BaseManifests and the Manifests of the Tests. They are
+	// only read from it when not found in the upstream manifests, so their
+	// paths should be distinct, e.g. prefixed with the Name of the extension.
+	// The manifests are prepared like the upstream ones, e.g. Gateways are
+	// set to use the GatewayClass of the suite.
+	Manifests fs.FS
+
+	// BaseManifests are applied during Setup, after the upstream base
+	// manifests. They can be used for resources shared by the tests of the
+	// extension, e.g. the CRDs of custom filters or backends.
+	BaseManifests []string
+}
+
+// extensionFeatures returns the features of all the extensions.
+func extensionFeatures(extensions []Extension) sets.Set[SupportedFeature] {
+	features := sets.New[SupportedFeature]()
+	for _, extension := range extensions {
+		features.Insert(extension.Features...)
+	}
+	return features
+}
+
+// extensionFor returns the extension the test with the given ShortName
+// belongs to, if any.
+func (suite *ConformanceTestSuite) extensionFor(shortName string) (Extension, bool) {
+	for _, extension := range suite.Extensions {
+		for _, test := range extension.Tests {
+			if test.ShortName == shortName {
+				return extension, true
+			}
+		}
+	}
+	return Extension{}, false
+}
+
+// withExtensionTests returns the given tests followed by the tests of all the
+// extensions, failing if any ShortName is used by more than one test.
+func (suite *ConformanceTestSuite) withExtensionTests(t *testing.T, tests []ConformanceTest) []ConformanceTest {
+	if len(suite.Extensions) == 0 {
+		return tests
+	}
+
+	all := make([]ConformanceTest, 0, len(tests))
+	all = append(all, tests...)
+	for _, extension := range suite.Extensions {
+		all = append(all, extension.Tests...)
+	}
+
+	names := sets.New[string]()
+	for _, test := range all {
+		if names.Has(test.ShortName) {
+			t.Fatalf("more than one test is named %s, test names must be unique across extensions", test.ShortName)
+		}
+		names.Insert(test.ShortName)
+	}
+	return all
+}
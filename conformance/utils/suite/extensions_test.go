@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const supportAcmeFilter SupportedFeature = "AcmeFilter"
+
+func acmeExtension(ran *[]string) Extension {
+	test := func(name string, features ...SupportedFeature) ConformanceTest {
+		return ConformanceTest{
+			ShortName: name,
+			Features:  features,
+			Test: func(t *testing.T, _ *ConformanceTestSuite) {
+				*ran = append(*ran, name)
+			},
+		}
+	}
+	return Extension{
+		Name:     "acme",
+		Features: []SupportedFeature{supportAcmeFilter},
+		Tests: []ConformanceTest{
+			test("AcmeGateway", SupportGateway),
+			test("AcmeFilter", SupportGateway, supportAcmeFilter),
+		},
+		Manifests: fstest.MapFS{"acme/filter.yaml": &fstest.MapFile{Data: []byte("kind: AcmeFilter")}},
+	}
+}
+
+func TestRunExtensions(t *testing.T) {
+	var ran []string
+	upstream := ConformanceTest{
+		ShortName: "Upstream",
+		Features:  []SupportedFeature{SupportGateway},
+		Test: func(t *testing.T, _ *ConformanceTestSuite) {
+			ran = append(ran, "Upstream")
+		},
+	}
+
+	suite := New(Options{Extensions: []Extension{acmeExtension(&ran)}})
+	require.Len(t, suite.Applier.ManifestFS, 1)
+
+	suite.Run(t, []ConformanceTest{upstream})
+	require.Equal(t, []string{"Upstream", "AcmeGateway"}, ran, "extension tests must be gated by their features")
+	require.Equal(t, resultSkippedUnsupported, suite.results["AcmeFilter"].result)
+}
+
+func TestRunExtensionsWithFeatures(t *testing.T) {
+	var ran []string
+	suite := New(Options{
+		SupportedFeatures: sets.New(supportAcmeFilter),
+		Extensions:        []Extension{acmeExtension(&ran)},
+	})
+	suite.Run(t, nil)
+	require.Equal(t, []string{"AcmeGateway", "AcmeFilter"}, ran)
+
+	ran = nil
+	suite = New(Options{EnableAllSupportedFeatures: true, Extensions: []Extension{acmeExtension(&ran)}})
+	suite.Run(t, nil)
+	require.Equal(t, []string{"AcmeGateway", "AcmeFilter"}, ran, "enabling all features must enable the features of extensions")
+	require.False(t, AllFeatures.Has(supportAcmeFilter), "the features of extensions must not leak into AllFeatures")
+}
+
+func TestRunExtensionsWithConformanceProfiles(t *testing.T) {
+	var ran []string
+	suite := New(Options{
+		ConformanceProfiles: sets.New(HTTPConformanceProfileName),
+		Extensions:          []Extension{acmeExtension(&ran)},
+	})
+	suite.Run(t, nil)
+	require.Equal(t, []string{"AcmeGateway"}, ran, "extension tests must not be filtered by conformance profiles")
+}
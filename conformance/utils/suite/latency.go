@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+// routeProgrammingPollInterval is the delay between the requests made while
+// waiting for a route change to be programmed. It is kept short, and constant,
+// so that it doesn't skew the measured latencies.
+const routeProgrammingPollInterval = 100 * time.Millisecond
+
+// MeasureRouteProgrammingLatency measures how long the implementation takes to
+// program route changes, by changing the path matched by an HTTPRoute the
+// given number of times, and timing how long it takes for requests to the new
+// path to be routed after each change. The latencies are logged and added to
+// the conformance report, but they have no bearing on conformance. The
+// measurement relies on a Gateway and is skipped in mesh mode.
+func (suite *ConformanceTestSuite) MeasureRouteProgrammingLatency(t *testing.T, samples int) {
+	t.Run("RouteProgrammingLatency", func(t *testing.T) {
+		if suite.Mesh {
+			t.Skip("Skipping RouteProgrammingLatency: it requires a Gateway, which is not available in mesh mode")
+		}
+
+		ns := "gateway-conformance-infra"
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		routeNN := types.NamespacedName{Name: "route-programming-latency", Namespace: ns}
+
+		// The measurement is not a conformance test, so the convergence to
+		// the initial route is not recorded.
+		timeoutConfig := suite.TimeoutConfig
+		timeoutConfig.Convergences = nil
+
+		route := latencyRoute(routeNN, gwNN, latencyPath(0))
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, timeoutConfig, []client.Object{route}, true)
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, timeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, timeoutConfig, gwAddr, http.ExpectedResponse{
+			Request:   http.Request{Path: latencyPath(0)},
+			Backend:   "infra-backend-v1",
+			Namespace: ns,
+		})
+
+		latencies := make([]time.Duration, 0, samples)
+		for i := 1; i <= samples; i++ {
+			path := latencyPath(i)
+			expected := http.ExpectedResponse{
+				Request:   http.Request{Path: path},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			}
+			req := http.MakeRequest(t, &expected, gwAddr, "HTTP", "http")
+
+			suite.setLatencyRoutePath(t, routeNN, path)
+			start := time.Now()
+			latency := suite.awaitFirstSuccess(t, req, expected, start)
+			t.Logf("Route change %d/%d programmed after %v", i, samples, latency)
+			latencies = append(latencies, latency)
+		}
+
+		suite.resultsLock.Lock()
+		defer suite.resultsLock.Unlock()
+		suite.routeProgrammingLatencies = append(suite.routeProgrammingLatencies, latencies...)
+	})
+}
+
+// setLatencyRoutePath changes the path matched by the route.
+func (suite *ConformanceTestSuite) setLatencyRoutePath(t *testing.T, routeNN types.NamespacedName, path string) {
+	ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.CreateTimeout)
+	defer cancel()
+
+	route := &v1beta1.HTTPRoute{}
+	err := suite.Client.Get(ctx, routeNN, route)
+	require.NoErrorf(t, err, "error getting HTTPRoute %s", routeNN)
+
+	original := route.DeepCopy()
+	route.Spec.Rules[0].Matches[0].Path.Value = &path
+	err = suite.Client.Patch(ctx, route, client.MergeFrom(original))
+	require.NoErrorf(t, err, "error patching HTTPRoute %s", routeNN)
+}
+
+// awaitFirstSuccess makes the request until it gets the expected response,
+// and returns the time elapsed since start.
+func (suite *ConformanceTestSuite) awaitFirstSuccess(t *testing.T, req roundtripper.Request, expected http.ExpectedResponse, start time.Time) time.Duration {
+	for time.Since(start) < suite.TimeoutConfig.MaxTimeToConsistency {
+		cReq, cRes, err := suite.RoundTripper.CaptureRoundTrip(req)
+		if err == nil && http.CompareRequest(&req, cReq, cRes, expected) == nil {
+			return time.Since(start)
+		}
+		time.Sleep(routeProgrammingPollInterval)
+	}
+	t.Fatalf("Route change to %s was not programmed after %v", expected.Request.Path, suite.TimeoutConfig.MaxTimeToConsistency)
+	return 0
+}
+
+func latencyPath(i int) string {
+	return fmt.Sprintf("/route-programming-latency-%d", i)
+}
+
+// latencyRoute returns the HTTPRoute whose path is changed to measure the
+// route programming latency.
+func latencyRoute(routeNN, gwNN types.NamespacedName, path string) *v1beta1.HTTPRoute {
+	gwNamespace := v1beta1.Namespace(gwNN.Namespace)
+	pathType := v1beta1.PathMatchExact
+	port := v1beta1.PortNumber(8080)
+	return &v1beta1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1beta1.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      routeNN.Name,
+			Namespace: routeNN.Namespace,
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			CommonRouteSpec: v1beta1.CommonRouteSpec{
+				ParentRefs: []v1beta1.ParentReference{{
+					Name:      v1beta1.ObjectName(gwNN.Name),
+					Namespace: &gwNamespace,
+				}},
+			},
+			Rules: []v1beta1.HTTPRouteRule{{
+				Matches: []v1beta1.HTTPRouteMatch{{
+					Path: &v1beta1.HTTPPathMatch{Type: &pathType, Value: &path},
+				}},
+				BackendRefs: []v1beta1.HTTPBackendRef{{
+					BackendRef: v1beta1.BackendRef{
+						BackendObjectReference: v1beta1.BackendObjectReference{
+							Name: "infra-backend-v1",
+							Port: &port,
+						},
+					},
+				}},
+			}},
+		},
+	}
+}
+
+// latencyPercentile returns the given percentile of the latencies, using the
+// nearest-rank method.
+func latencyPercentile(latencies []time.Duration, percentile int) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := (percentile*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ListFormat is the format in which conformance tests are listed.
+type ListFormat string
+
+const (
+	// ListFormatText lists tests in a human readable format.
+	ListFormatText ListFormat = "text"
+
+	// ListFormatJSON lists tests as a JSON array.
+	ListFormatJSON ListFormat = "json"
+)
+
+// testDescription describes a conformance test when listing tests.
+type testDescription struct {
+	ShortName   string   `json:"shortName"`
+	Description string   `json:"description"`
+	Features    []string `json:"features,omitempty"`
+	Manifests   []string `json:"manifests,omitempty"`
+}
+
+// ListTests writes the ShortName, Description, Features and Manifests of each
+// of the provided tests to w in the given format. It does not require access
+// to a cluster.
+func ListTests(w io.Writer, tests []ConformanceTest, format ListFormat) error {
+	descriptions := make([]testDescription, 0, len(tests))
+	for _, test := range tests {
+		desc := testDescription{
+			ShortName:   test.ShortName,
+			Description: test.Description,
+			Manifests:   test.Manifests,
+		}
+		for _, feature := range test.Features {
+			desc.Features = append(desc.Features, string(feature))
+		}
+		descriptions = append(descriptions, desc)
+	}
+
+	switch format {
+	case ListFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(descriptions)
+	case ListFormatText, "":
+		var b strings.Builder
+		for _, desc := range descriptions {
+			fmt.Fprintf(&b, "%s\n  Description: %s\n", desc.ShortName, desc.Description)
+			if len(desc.Features) > 0 {
+				fmt.Fprintf(&b, "  Features: %s\n", strings.Join(desc.Features, ", "))
+			}
+			if len(desc.Manifests) > 0 {
+				fmt.Fprintf(&b, "  Manifests: %s\n", strings.Join(desc.Manifests, ", "))
+			}
+		}
+		_, err := io.WriteString(w, b.String())
+		return err
+	default:
+		return fmt.Errorf("%s is not a valid list format, valid formats are: %v", format, []ListFormat{ListFormatText, ListFormatJSON})
+	}
+}
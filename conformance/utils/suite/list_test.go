@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var listedTests = []ConformanceTest{{
+	ShortName:   "HTTPRouteSimple",
+	Description: "A simple HTTPRoute",
+	Features:    []SupportedFeature{SupportGateway, SupportHTTPRoute},
+	Manifests:   []string{"tests/httproute-simple.yaml"},
+}, {
+	ShortName:   "NoManifests",
+	Description: "A test without manifests",
+}}
+
+func TestListTests(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   ListFormat
+		expected string
+	}{{
+		name:   "text",
+		format: ListFormatText,
+		expected: `HTTPRouteSimple
+  Description: A simple HTTPRoute
+  Features: Gateway, HTTPRoute
+  Manifests: tests/httproute-simple.yaml
+NoManifests
+  Description: A test without manifests
+`,
+	}, {
+		name:   "json",
+		format: ListFormatJSON,
+		expected: `[
+  {
+    "shortName": "HTTPRouteSimple",
+    "description": "A simple HTTPRoute",
+    "features": [
+      "Gateway",
+      "HTTPRoute"
+    ],
+    "manifests": [
+      "tests/httproute-simple.yaml"
+    ]
+  },
+  {
+    "shortName": "NoManifests",
+    "description": "A test without manifests"
+  }
+]
+`,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			require.NoError(t, ListTests(&out, listedTests, tc.format))
+			require.Equal(t, tc.expected, out.String())
+		})
+	}
+}
+
+func TestListTestsInvalidFormat(t *testing.T) {
+	var out bytes.Buffer
+	require.Error(t, ListTests(&out, listedTests, "yaml"))
+}
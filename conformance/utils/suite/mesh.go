@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+const (
+	// MeshNamespace is the namespace of the mesh base resources, which
+	// include the echo Services routes are attached to in mesh mode, and the
+	// client Pod requests are made from.
+	MeshNamespace = "gateway-conformance-mesh"
+
+	// meshBaseManifests are the base manifests applied in mesh mode.
+	meshBaseManifests = "mesh/manifests.yaml"
+)
+
+// meshClientSelector selects the client Pod of the mesh base manifests.
+var meshClientSelector = map[string]string{"app": "mesh-client"}
+
+// setupMesh ensures the Pods of the mesh base manifests are ready, and points
+// the default RoundTripper at the client Pod.
+func (suite *ConformanceTestSuite) setupMesh(t *testing.T) {
+	t.Logf("Test Setup: Ensuring Pods from mesh base manifests are ready")
+	kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{MeshNamespace})
+
+	if rt, ok := suite.RoundTripper.(*roundtripper.ExecRoundTripper); ok && rt.Pod.Name == "" {
+		rt.Pod = kubernetes.PodMustBeRunning(t, suite.Client, suite.TimeoutConfig, MeshNamespace, meshClientSelector)
+		t.Logf("Test Setup: Making requests from Pod %s", rt.Pod)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+func TestNewMesh(t *testing.T) {
+	suite := New(Options{Mesh: true})
+
+	require.True(t, suite.SupportedFeatures.Equal(MeshConformanceProfile.CoreFeatures))
+	require.IsType(t, &roundtripper.ExecRoundTripper{}, suite.RoundTripper)
+	require.Equal(t, "mesh/manifests.yaml", suite.BaseManifests)
+	require.Equal(t, MeshNamespace, suite.Applier.TemplateValues.Namespaces.Mesh)
+
+	suite = New(Options{})
+	require.True(t, suite.SupportedFeatures.Equal(StandardCoreFeatures))
+	require.IsType(t, &roundtripper.DefaultRoundTripper{}, suite.RoundTripper)
+	require.Equal(t, "base/manifests.yaml", suite.BaseManifests)
+}
+
+func TestRunMesh(t *testing.T) {
+	tests := func(ran *[]string) []ConformanceTest {
+		test := func(name string, features ...SupportedFeature) ConformanceTest {
+			return ConformanceTest{
+				ShortName: name,
+				Features:  features,
+				Test: func(t *testing.T, _ *ConformanceTestSuite) {
+					*ran = append(*ran, name)
+				},
+			}
+		}
+		return []ConformanceTest{
+			test("gateway", SupportGateway, SupportHTTPRoute),
+			test("no-features"),
+			test("mesh", SupportMesh, SupportHTTPRoute),
+		}
+	}
+
+	var ran []string
+	New(Options{Mesh: true}).Run(t, tests(&ran))
+	require.Equal(t, []string{"mesh"}, ran)
+
+	ran = nil
+	New(Options{EnableAllSupportedFeatures: true}).Run(t, tests(&ran))
+	require.Equal(t, []string{"gateway", "no-features"}, ran)
+}
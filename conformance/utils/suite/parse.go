@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ParseSupportedFeatures parses a comma-separated list of feature names. Names
+// must be part of AllFeatures, or of the features of the given extensions. An
+// empty list is parsed as a nil set.
+func ParseSupportedFeatures(s string, extensions ...Extension) (sets.Set[SupportedFeature], error) {
+	valid := AllFeatures.Union(extensionFeatures(extensions))
+
+	var features sets.Set[SupportedFeature]
+	for _, name := range splitList(s) {
+		feature := SupportedFeature(name)
+		if !valid.Has(feature) {
+			return nil, fmt.Errorf("unknown feature %q, valid features are: %s", name, strings.Join(sortedFeatures(valid), ", "))
+		}
+		if features == nil {
+			features = sets.New[SupportedFeature]()
+		}
+		features.Insert(feature)
+	}
+	return features, nil
+}
+
+// ParseTestNames parses a comma-separated list of test ShortNames. Names must
+// be the ShortName of one of the given tests, or of the tests of the given
+// extensions.
+func ParseTestNames(s string, tests []ConformanceTest, extensions ...Extension) ([]string, error) {
+	valid := sets.New[string]()
+	for _, test := range tests {
+		valid.Insert(test.ShortName)
+	}
+	for _, extension := range extensions {
+		for _, test := range extension.Tests {
+			valid.Insert(test.ShortName)
+		}
+	}
+
+	var names []string
+	for _, name := range splitList(s) {
+		if !valid.Has(name) {
+			return nil, fmt.Errorf("unknown test %q, valid tests are: %s", name, strings.Join(sets.List(valid), ", "))
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// splitList splits a comma-separated list, ignoring empty values and
+// surrounding whitespace.
+func splitList(s string) []string {
+	var values []string
+	for _, value := range strings.Split(s, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func sortedFeatures(features sets.Set[SupportedFeature]) []string {
+	if features.Len() == 0 {
+		return nil
+	}
+	names := make([]string, 0, features.Len())
+	for feature := range features {
+		names = append(names, string(feature))
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestParseSupportedFeatures(t *testing.T) {
+	features, err := ParseSupportedFeatures("")
+	require.NoError(t, err)
+	require.Nil(t, features, "unset features must not be parsed as an empty feature")
+
+	features, err = ParseSupportedFeatures(" HTTPRouteMethodMatching, ,TLSRoute")
+	require.NoError(t, err)
+	require.Equal(t, sets.New(SupportHTTPRouteMethodMatching, SupportTLSRoute), features)
+
+	_, err = ParseSupportedFeatures("HTTPRouteMethodMatchin")
+	require.ErrorContains(t, err, `unknown feature "HTTPRouteMethodMatchin", valid features are: `)
+	require.ErrorContains(t, err, string(SupportHTTPRouteMethodMatching))
+
+	var ran []string
+	features, err = ParseSupportedFeatures(string(supportAcmeFilter), acmeExtension(&ran))
+	require.NoError(t, err)
+	require.Equal(t, sets.New(supportAcmeFilter), features)
+}
+
+func TestParseTestNames(t *testing.T) {
+	tests := []ConformanceTest{{ShortName: "First"}, {ShortName: "Second"}}
+
+	names, err := ParseTestNames("Second, First", tests)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Second", "First"}, names)
+
+	names, err = ParseTestNames("", tests)
+	require.NoError(t, err)
+	require.Empty(t, names)
+
+	_, err = ParseTestNames("Third", tests)
+	require.EqualError(t, err, `unknown test "Third", valid tests are: First, Second`)
+}
+
+func TestNewExemptFeatures(t *testing.T) {
+	suite := New(Options{
+		ExemptFeatures:      sets.New(SupportReferenceGrant),
+		ConformanceProfiles: sets.New(HTTPConformanceProfileName),
+	})
+	require.False(t, suite.SupportedFeatures.Has(SupportReferenceGrant), "exempt features must not be added back as core features")
+	require.True(t, suite.SupportedFeatures.Has(SupportHTTPRoute))
+	require.True(t, StandardCoreFeatures.Has(SupportReferenceGrant), "the shared core features must not be modified")
+
+	suite = New(Options{
+		EnableAllSupportedFeatures: true,
+		ExemptFeatures:             sets.New(SupportTLSRoute),
+	})
+	require.False(t, suite.SupportedFeatures.Has(SupportTLSRoute))
+	require.True(t, AllFeatures.Has(SupportTLSRoute))
+}
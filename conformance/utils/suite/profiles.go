@@ -0,0 +1,217 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ConformanceProfileName is the name of a conformance profile.
+type ConformanceProfileName string
+
+const (
+	// HTTPConformanceProfileName indicates the name of the conformance profile
+	// which covers HTTP functionality with Gateways.
+	HTTPConformanceProfileName ConformanceProfileName = "HTTP"
+
+	// TLSConformanceProfileName indicates the name of the conformance profile
+	// which covers TLS stream functionality with Gateways.
+	TLSConformanceProfileName ConformanceProfileName = "TLS"
+
+	// GRPCConformanceProfileName indicates the name of the conformance profile
+	// which covers GRPC functionality with Gateways.
+	GRPCConformanceProfileName ConformanceProfileName = "GRPC"
+
+	// TCPConformanceProfileName indicates the name of the conformance profile
+	// which covers TCP stream functionality with Gateways.
+	TCPConformanceProfileName ConformanceProfileName = "TCP"
+
+	// UDPConformanceProfileName indicates the name of the conformance profile
+	// which covers UDP stream functionality with Gateways.
+	UDPConformanceProfileName ConformanceProfileName = "UDP"
+
+	// MeshConformanceProfileName indicates the name of the conformance profile
+	// which covers service mesh (GAMMA) functionality.
+	MeshConformanceProfileName ConformanceProfileName = "Mesh"
+)
+
+// ConformanceProfile is a group of features that have a related purpose, e.g.
+// to cover specific protocol support or a specific feature present in Gateway
+// API.
+//
+// A test is part of a profile if all of the features it exercises are either
+// core or extended features of that profile. Tests which only exercise core
+// features make up the core tests of the profile, which an implementation must
+// pass to be conformant with it.
+type ConformanceProfile struct {
+	Name             ConformanceProfileName
+	CoreFeatures     sets.Set[SupportedFeature]
+	ExtendedFeatures sets.Set[SupportedFeature]
+}
+
+var (
+	// HTTPConformanceProfile is a ConformanceProfile that covers testing HTTP
+	// related functionality with Gateways.
+	HTTPConformanceProfile = ConformanceProfile{
+		Name: HTTPConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportHTTPRoute,
+		),
+		ExtendedFeatures: sets.New(
+			SupportGatewayClassObservedGenerationBump,
+			SupportGatewayStaticAddresses,
+			SupportRouteDestinationPortMatching,
+			SupportHTTPRouteQueryParamMatching,
+			SupportHTTPRouteMethodMatching,
+			SupportHTTPResponseHeaderModification,
+			SupportHTTPRoutePortRedirect,
+			SupportHTTPRouteSchemeRedirect,
+			SupportHTTPRoutePathRedirect,
+			SupportHTTPRouteHostRewrite,
+			SupportHTTPRoutePathRewrite,
+			SupportHTTPRouteRequestMirror,
+			SupportHTTPRouteWebSocket,
+		),
+	}
+
+	// TLSConformanceProfile is a ConformanceProfile that covers testing TLS
+	// related functionality with Gateways.
+	TLSConformanceProfile = ConformanceProfile{
+		Name: TLSConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportTLSRoute,
+		),
+		ExtendedFeatures: sets.New(
+			SupportGatewayClassObservedGenerationBump,
+			SupportGatewayStaticAddresses,
+		),
+	}
+
+	// GRPCConformanceProfile is a ConformanceProfile that covers testing GRPC
+	// related functionality with Gateways.
+	GRPCConformanceProfile = ConformanceProfile{
+		Name: GRPCConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportGRPCRoute,
+		),
+		ExtendedFeatures: sets.New(
+			SupportGatewayClassObservedGenerationBump,
+			SupportGatewayStaticAddresses,
+			SupportGRPCRouteRegexMatching,
+		),
+	}
+
+	// TCPConformanceProfile is a ConformanceProfile that covers testing TCP
+	// related functionality with Gateways.
+	TCPConformanceProfile = ConformanceProfile{
+		Name: TCPConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportTCPRoute,
+		),
+		ExtendedFeatures: sets.New(
+			SupportGatewayClassObservedGenerationBump,
+			SupportGatewayStaticAddresses,
+		),
+	}
+
+	// UDPConformanceProfile is a ConformanceProfile that covers testing UDP
+	// related functionality with Gateways.
+	UDPConformanceProfile = ConformanceProfile{
+		Name: UDPConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportUDPRoute,
+		),
+		ExtendedFeatures: sets.New(
+			SupportGatewayClassObservedGenerationBump,
+			SupportGatewayStaticAddresses,
+		),
+	}
+
+	// MeshConformanceProfile is a ConformanceProfile that covers testing
+	// service mesh related functionality, where routes are attached to
+	// Services instead of Gateways.
+	MeshConformanceProfile = ConformanceProfile{
+		Name: MeshConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportMesh,
+			SupportHTTPRoute,
+		),
+		ExtendedFeatures: sets.New[SupportedFeature](),
+	}
+)
+
+// conformanceProfileMap maps short human-readable names to their respective
+// ConformanceProfiles.
+var conformanceProfileMap = map[ConformanceProfileName]ConformanceProfile{
+	HTTPConformanceProfileName: HTTPConformanceProfile,
+	TLSConformanceProfileName:  TLSConformanceProfile,
+	GRPCConformanceProfileName: GRPCConformanceProfile,
+	TCPConformanceProfileName:  TCPConformanceProfile,
+	UDPConformanceProfileName:  UDPConformanceProfile,
+	MeshConformanceProfileName: MeshConformanceProfile,
+}
+
+// getConformanceProfileForName retrieves a known ConformanceProfile by its
+// simple human readable ConformanceProfileName.
+func getConformanceProfileForName(name ConformanceProfileName) (ConformanceProfile, error) {
+	profile, ok := conformanceProfileMap[name]
+	if !ok {
+		return profile, fmt.Errorf("%s is not a valid conformance profile, valid profiles are: %v", name, ConformanceProfileNames())
+	}
+	return profile, nil
+}
+
+// ConformanceProfileNames returns the sorted names of all known conformance
+// profiles.
+func ConformanceProfileNames() []ConformanceProfileName {
+	names := make([]ConformanceProfileName, 0, len(conformanceProfileMap))
+	for name := range conformanceProfileMap {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+// Features returns all the core and extended features of the profile.
+func (p ConformanceProfile) Features() sets.Set[SupportedFeature] {
+	return p.CoreFeatures.Union(p.ExtendedFeatures)
+}
+
+// HasTest returns true if all the features exercised by the test are part of
+// the profile.
+func (p ConformanceProfile) HasTest(test ConformanceTest) bool {
+	return len(test.Features) > 0 && p.Features().HasAll(test.Features...)
+}
+
+// IsCoreTest returns true if the test is part of the profile and only
+// exercises core features of it.
+func (p ConformanceProfile) IsCoreTest(test ConformanceTest) bool {
+	return len(test.Features) > 0 && p.CoreFeatures.HasAll(test.Features...)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestConformanceProfileMembership(t *testing.T) {
+	tests := []struct {
+		name     string
+		profile  ConformanceProfile
+		features []SupportedFeature
+		hasTest  bool
+		isCore   bool
+	}{{
+		name:     "core HTTP test",
+		profile:  HTTPConformanceProfile,
+		features: []SupportedFeature{SupportGateway, SupportHTTPRoute},
+		hasTest:  true,
+		isCore:   true,
+	}, {
+		name:     "extended HTTP test",
+		profile:  HTTPConformanceProfile,
+		features: []SupportedFeature{SupportGateway, SupportHTTPRoute, SupportHTTPRouteMethodMatching},
+		hasTest:  true,
+		isCore:   false,
+	}, {
+		name:     "TLS test is not part of the HTTP profile",
+		profile:  HTTPConformanceProfile,
+		features: []SupportedFeature{SupportGateway, SupportTLSRoute},
+		hasTest:  false,
+		isCore:   false,
+	}, {
+		name:     "Gateway test is part of the TLS profile",
+		profile:  TLSConformanceProfile,
+		features: []SupportedFeature{SupportGateway},
+		hasTest:  true,
+		isCore:   true,
+	}, {
+		name:     "core TCP test",
+		profile:  TCPConformanceProfile,
+		features: []SupportedFeature{SupportGateway, SupportTCPRoute},
+		hasTest:  true,
+		isCore:   true,
+	}, {
+		name:     "UDP test is not part of the TCP profile",
+		profile:  TCPConformanceProfile,
+		features: []SupportedFeature{SupportGateway, SupportUDPRoute},
+		hasTest:  false,
+		isCore:   false,
+	}, {
+		name:     "Gateway test is not part of the Mesh profile",
+		profile:  MeshConformanceProfile,
+		features: []SupportedFeature{SupportGateway, SupportHTTPRoute},
+		hasTest:  false,
+		isCore:   false,
+	}, {
+		name:     "test without features is not part of any profile",
+		profile:  HTTPConformanceProfile,
+		features: nil,
+		hasTest:  false,
+		isCore:   false,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			test := ConformanceTest{ShortName: "Test", Features: tc.features}
+			require.Equal(t, tc.hasTest, tc.profile.HasTest(test))
+			require.Equal(t, tc.isCore, tc.profile.IsCoreTest(test))
+		})
+	}
+}
+
+func TestNewWithConformanceProfiles(t *testing.T) {
+	cSuite := New(Options{
+		SupportedFeatures:   sets.New(SupportHTTPRouteMethodMatching),
+		ConformanceProfiles: sets.New(TLSConformanceProfileName),
+	})
+
+	require.True(t, cSuite.SupportedFeatures.HasAll(TLSConformanceProfile.CoreFeatures.UnsortedList()...))
+	require.True(t, cSuite.SupportedFeatures.Has(SupportHTTPRouteMethodMatching))
+	require.False(t, StandardCoreFeatures.Has(SupportTLSRoute), "StandardCoreFeatures must not be modified")
+
+	require.True(t, cSuite.inConformanceProfiles(&ConformanceTest{Features: []SupportedFeature{SupportGateway, SupportTLSRoute}}))
+	require.False(t, cSuite.inConformanceProfiles(&ConformanceTest{Features: []SupportedFeature{SupportGateway, SupportHTTPRoute}}))
+}
+
+func TestGetConformanceProfileForName(t *testing.T) {
+	profile, err := getConformanceProfileForName(HTTPConformanceProfileName)
+	require.NoError(t, err)
+	require.Equal(t, HTTPConformanceProfileName, profile.Name)
+
+	_, err = getConformanceProfileForName("Unknown")
+	require.ErrorContains(t, err, "valid profiles are: [GRPC HTTP Mesh TCP TLS UDP]")
+}
@@ -0,0 +1,232 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+)
+
+// defaultConformanceProfileName is the name of the profile that all test
+// results are grouped into when no conformance profiles were selected.
+const defaultConformanceProfileName ConformanceProfileName = "Default"
+
+// Report builds a ConformanceReport for the given implementation out of the
+// results of all the tests that have been run by the suite so far. Results are
+// grouped by the conformance profiles selected for the suite.
+func (suite *ConformanceTestSuite) Report(implementation confv1a1.Implementation) (*confv1a1.ConformanceReport, error) {
+	suite.resultsLock.Lock()
+	defer suite.resultsLock.Unlock()
+
+	if len(suite.results) == 0 {
+		return nil, errors.New("no conformance tests have been run")
+	}
+
+	testNames := make([]string, 0, len(suite.results))
+	for name := range suite.results {
+		testNames = append(testNames, name)
+	}
+	sort.Strings(testNames)
+
+	results := make([]testResult, 0, len(testNames))
+	extensionResults := map[string][]testResult{}
+	for _, name := range testNames {
+		if extension, ok := suite.extensionFor(name); ok {
+			extensionResults[extension.Name] = append(extensionResults[extension.Name], suite.results[name])
+			continue
+		}
+		results = append(results, suite.results[name])
+	}
+
+	var profiles []confv1a1.ConformanceProfile
+	if suite.ConformanceProfiles.Len() == 0 {
+		defaultProfile := ConformanceProfile{
+			Name:             defaultConformanceProfileName,
+			CoreFeatures:     StandardCoreFeatures,
+			ExtendedFeatures: AllFeatures.Difference(StandardCoreFeatures),
+		}
+		profiles = append(profiles, suite.buildProfile(defaultProfile, results, true))
+	} else {
+		for _, name := range sets.List(suite.ConformanceProfiles) {
+			profile, err := getConformanceProfileForName(name)
+			if err != nil {
+				return nil, err
+			}
+			profiles = append(profiles, suite.buildProfile(profile, results, false))
+		}
+	}
+
+	// Results of runs that can't be used to claim conformance are never a
+	// core success.
+	if len(suite.NonConformantReasons) > 0 {
+		for i := range profiles {
+			if profiles[i].Core.Result == confv1a1.Success {
+				profiles[i].Core.Result = confv1a1.Partial
+			}
+		}
+	}
+
+	return &confv1a1.ConformanceReport{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "gateway.networking.k8s.io/v1alpha1",
+			Kind:       "ConformanceReport",
+		},
+		Implementation:          implementation,
+		Date:                    time.Now().Format(time.RFC3339),
+		GatewayAPIVersion:       suite.GatewayAPIVersion,
+		NonConformantReasons:    suite.NonConformantReasons,
+		SupportedFeatures:       sortedFeatures(suite.SupportedFeatures),
+		ExemptFeatures:          sortedFeatures(suite.ExemptFeatures),
+		SkippedTests:            sortedTests(suite.SkipTests),
+		Profiles:                profiles,
+		Extensions:              suite.buildExtensions(extensionResults),
+		Metrics:                 buildMetrics(testNames, suite.results),
+		RouteProgrammingLatency: buildLatencyMetrics(suite.routeProgrammingLatencies),
+	}, nil
+}
+
+// buildMetrics returns the metrics of the tests, in the given order, which
+// awaited successful responses.
+func buildMetrics(testNames []string, results map[string]testResult) []confv1a1.TestMetrics {
+	var metrics []confv1a1.TestMetrics
+	for _, name := range testNames {
+		res := results[name]
+		if res.convergences == 0 {
+			continue
+		}
+		metrics = append(metrics, confv1a1.TestMetrics{
+			Name:               name,
+			TimeToFirstSuccess: metav1.Duration{Duration: res.timeToFirstSuccess},
+			TimeToConsistency:  metav1.Duration{Duration: res.timeToConsistency},
+		})
+	}
+	return metrics
+}
+
+// buildLatencyMetrics summarizes the latencies, if any.
+func buildLatencyMetrics(latencies []time.Duration) *confv1a1.LatencyMetrics {
+	if len(latencies) == 0 {
+		return nil
+	}
+	return &confv1a1.LatencyMetrics{
+		Samples: len(latencies),
+		P50:     metav1.Duration{Duration: latencyPercentile(latencies, 50)},
+		P90:     metav1.Duration{Duration: latencyPercentile(latencies, 90)},
+		Max:     metav1.Duration{Duration: latencyPercentile(latencies, 100)},
+	}
+}
+
+// buildExtensions summarizes the results of the tests of each extension. As
+// the tests of extensions are optional, they are all accounted for as if they
+// were extended tests.
+func (suite *ConformanceTestSuite) buildExtensions(results map[string][]testResult) []confv1a1.ExtensionReport {
+	var reports []confv1a1.ExtensionReport
+	for _, extension := range suite.Extensions {
+		report := confv1a1.ExtensionReport{Name: extension.Name}
+		for _, res := range results[extension.Name] {
+			countResult(&report.Results.Status, res)
+		}
+		summarize(&report.Results.Status, len(report.Results.SkippedTests) > 0)
+
+		features := sets.New(extension.Features...)
+		report.Results.SupportedFeatures = sortedFeatures(features.Intersection(suite.SupportedFeatures))
+		report.Results.UnsupportedFeatures = sortedFeatures(features.Difference(suite.SupportedFeatures))
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// buildProfile summarizes the results of the tests which are part of the
+// provided profile, or of all results if includeAll is set. Tests which
+// exercise any extended feature of the profile are accounted for in the
+// extended support level, all others in the core support level.
+func (suite *ConformanceTestSuite) buildProfile(profile ConformanceProfile, results []testResult, includeAll bool) confv1a1.ConformanceProfile {
+	report := confv1a1.ConformanceProfile{Name: string(profile.Name)}
+
+	for _, res := range results {
+		if !includeAll && !profile.HasTest(res.test) {
+			continue
+		}
+
+		status := &report.Core
+		if profile.ExtendedFeatures.HasAny(res.test.Features...) {
+			status = &report.Extended.Status
+		}
+
+		countResult(status, res)
+	}
+
+	// Skipping core tests for any reason means the core results are partial,
+	// whereas extended tests are expected to be skipped for unsupported
+	// features and only explicitly skipped tests make them partial.
+	summarize(&report.Core, report.Core.Statistics.Skipped > 0)
+	summarize(&report.Extended.Status, len(report.Extended.SkippedTests) > 0)
+
+	report.Extended.SupportedFeatures = sortedFeatures(profile.ExtendedFeatures.Intersection(suite.SupportedFeatures))
+	report.Extended.UnsupportedFeatures = sortedFeatures(profile.ExtendedFeatures.Difference(suite.SupportedFeatures))
+
+	return report
+}
+
+// countResult adds the result of a test to the statistics of a status.
+func countResult(status *confv1a1.Status, res testResult) {
+	switch res.result {
+	case resultSuccess:
+		status.Statistics.Passed++
+	case resultFailure:
+		status.Statistics.Failed++
+	case resultSkippedExplicitly:
+		status.Statistics.Skipped++
+		status.SkippedTests = append(status.SkippedTests, res.test.ShortName)
+	case resultSkippedUnsupported:
+		status.Statistics.Skipped++
+	}
+}
+
+// summarize sets the Result and Summary of a status based on its statistics.
+func summarize(status *confv1a1.Status, partial bool) {
+	stats := status.Statistics
+	switch {
+	case stats.Passed+stats.Failed+stats.Skipped == 0:
+		status.Summary = "no tests were run"
+		return
+	case stats.Failed > 0:
+		status.Result = confv1a1.Failure
+	case partial:
+		status.Result = confv1a1.Partial
+	default:
+		status.Result = confv1a1.Success
+	}
+	status.Summary = fmt.Sprintf("%d tests passed, %d tests failed, %d tests skipped", stats.Passed, stats.Failed, stats.Skipped)
+}
+
+func sortedTests(tests sets.Set[string]) []string {
+	if tests.Len() == 0 {
+		return nil
+	}
+	return sets.List(tests)
+}
@@ -0,0 +1,252 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+func TestReport(t *testing.T) {
+	coreTest := ConformanceTest{ShortName: "Core"}
+	grantTest := ConformanceTest{ShortName: "Grant", Features: []SupportedFeature{SupportReferenceGrant}}
+	methodTest := ConformanceTest{ShortName: "Method", Features: []SupportedFeature{SupportHTTPRouteMethodMatching}}
+	tlsTest := ConformanceTest{ShortName: "TLS", Features: []SupportedFeature{SupportTLSRoute}}
+
+	tests := []struct {
+		name             string
+		results          []testResult
+		expectedCore     confv1a1.Status
+		expectedExtended confv1a1.Status
+	}{{
+		name: "all passing",
+		results: []testResult{
+			{test: coreTest, result: resultSuccess},
+			{test: grantTest, result: resultSuccess},
+			{test: methodTest, result: resultSuccess},
+			{test: tlsTest, result: resultSkippedUnsupported},
+		},
+		expectedCore: confv1a1.Status{
+			Result:     confv1a1.Success,
+			Summary:    "2 tests passed, 0 tests failed, 0 tests skipped",
+			Statistics: confv1a1.Statistics{Passed: 2},
+		},
+		expectedExtended: confv1a1.Status{
+			Result:     confv1a1.Success,
+			Summary:    "1 tests passed, 0 tests failed, 1 tests skipped",
+			Statistics: confv1a1.Statistics{Passed: 1, Skipped: 1},
+		},
+	}, {
+		name: "explicitly skipped tests are partial",
+		results: []testResult{
+			{test: coreTest, result: resultSkippedExplicitly},
+			{test: grantTest, result: resultSuccess},
+			{test: methodTest, result: resultSkippedExplicitly},
+		},
+		expectedCore: confv1a1.Status{
+			Result:       confv1a1.Partial,
+			Summary:      "1 tests passed, 0 tests failed, 1 tests skipped",
+			Statistics:   confv1a1.Statistics{Passed: 1, Skipped: 1},
+			SkippedTests: []string{"Core"},
+		},
+		expectedExtended: confv1a1.Status{
+			Result:       confv1a1.Partial,
+			Summary:      "0 tests passed, 0 tests failed, 1 tests skipped",
+			Statistics:   confv1a1.Statistics{Skipped: 1},
+			SkippedTests: []string{"Method"},
+		},
+	}, {
+		name: "failures take precedence",
+		results: []testResult{
+			{test: coreTest, result: resultFailure},
+			{test: grantTest, result: resultSkippedUnsupported},
+		},
+		expectedCore: confv1a1.Status{
+			Result:     confv1a1.Failure,
+			Summary:    "0 tests passed, 1 tests failed, 1 tests skipped",
+			Statistics: confv1a1.Statistics{Failed: 1, Skipped: 1},
+		},
+		expectedExtended: confv1a1.Status{
+			Summary: "no tests were run",
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := &ConformanceTestSuite{
+				SupportedFeatures: sets.New(SupportReferenceGrant, SupportHTTPRouteMethodMatching),
+				GatewayAPIVersion: "v0.6.2",
+				results:           map[string]testResult{},
+			}
+			for _, res := range tc.results {
+				suite.results[res.test.ShortName] = res
+			}
+
+			report, err := suite.Report(confv1a1.Implementation{Project: "test"})
+			require.NoError(t, err)
+			require.Equal(t, "ConformanceReport", report.Kind)
+			require.Equal(t, "test", report.Project)
+			require.Equal(t, "v0.6.2", report.GatewayAPIVersion)
+			require.Len(t, report.Profiles, 1)
+
+			profile := report.Profiles[0]
+			require.Equal(t, tc.expectedCore, profile.Core)
+			require.Equal(t, tc.expectedExtended, profile.Extended.Status)
+			require.Equal(t, []string{string(SupportHTTPRouteMethodMatching)}, profile.Extended.SupportedFeatures)
+			require.NotContains(t, profile.Extended.UnsupportedFeatures, string(SupportHTTPRouteMethodMatching))
+			require.Contains(t, profile.Extended.UnsupportedFeatures, string(SupportTLSRoute))
+		})
+	}
+}
+
+func TestReportWithConformanceProfiles(t *testing.T) {
+	suite := &ConformanceTestSuite{
+		SupportedFeatures:   sets.New(SupportGateway, SupportHTTPRoute, SupportReferenceGrant, SupportTLSRoute, SupportHTTPRouteMethodMatching),
+		ConformanceProfiles: sets.New(TLSConformanceProfileName, HTTPConformanceProfileName),
+		results:             map[string]testResult{},
+	}
+	for _, res := range []testResult{
+		{test: ConformanceTest{ShortName: "Gateway", Features: []SupportedFeature{SupportGateway}}, result: resultSuccess},
+		{test: ConformanceTest{ShortName: "HTTP", Features: []SupportedFeature{SupportGateway, SupportHTTPRoute}}, result: resultSuccess},
+		{test: ConformanceTest{ShortName: "Method", Features: []SupportedFeature{SupportGateway, SupportHTTPRoute, SupportHTTPRouteMethodMatching}}, result: resultFailure},
+		{test: ConformanceTest{ShortName: "TLS", Features: []SupportedFeature{SupportGateway, SupportTLSRoute}}, result: resultSuccess},
+	} {
+		suite.results[res.test.ShortName] = res
+	}
+
+	report, err := suite.Report(confv1a1.Implementation{})
+	require.NoError(t, err)
+	require.Len(t, report.Profiles, 2)
+
+	http := report.Profiles[0]
+	require.Equal(t, string(HTTPConformanceProfileName), http.Name)
+	require.Equal(t, confv1a1.Success, http.Core.Result)
+	require.Equal(t, confv1a1.Statistics{Passed: 2}, http.Core.Statistics)
+	require.Equal(t, confv1a1.Failure, http.Extended.Result)
+	require.Equal(t, confv1a1.Statistics{Failed: 1}, http.Extended.Statistics)
+	require.Equal(t, []string{string(SupportHTTPRouteMethodMatching)}, http.Extended.SupportedFeatures)
+
+	tls := report.Profiles[1]
+	require.Equal(t, string(TLSConformanceProfileName), tls.Name)
+	require.Equal(t, confv1a1.Success, tls.Core.Result)
+	require.Equal(t, confv1a1.Statistics{Passed: 2}, tls.Core.Statistics)
+	require.Equal(t, "no tests were run", tls.Extended.Summary)
+}
+
+func TestReportWithoutResults(t *testing.T) {
+	suite := &ConformanceTestSuite{results: map[string]testResult{}}
+	_, err := suite.Report(confv1a1.Implementation{})
+	require.Error(t, err)
+}
+
+func TestReportWithExtensions(t *testing.T) {
+	var ran []string
+	suite := &ConformanceTestSuite{
+		SupportedFeatures: sets.New(SupportGateway, SupportHTTPRoute, SupportReferenceGrant),
+		Extensions:        []Extension{acmeExtension(&ran)},
+		results:           map[string]testResult{},
+	}
+	for _, res := range []testResult{
+		{test: ConformanceTest{ShortName: "HTTP", Features: []SupportedFeature{SupportGateway, SupportHTTPRoute}}, result: resultSuccess},
+		{test: suite.Extensions[0].Tests[0], result: resultFailure},
+		{test: suite.Extensions[0].Tests[1], result: resultSkippedUnsupported},
+	} {
+		suite.results[res.test.ShortName] = res
+	}
+
+	report, err := suite.Report(confv1a1.Implementation{})
+	require.NoError(t, err)
+
+	require.Len(t, report.Profiles, 1)
+	require.Equal(t, confv1a1.Success, report.Profiles[0].Core.Result, "extension results must not affect the conformance profiles")
+	require.Equal(t, confv1a1.Statistics{Passed: 1}, report.Profiles[0].Core.Statistics)
+
+	require.Len(t, report.Extensions, 1)
+	acme := report.Extensions[0]
+	require.Equal(t, "acme", acme.Name)
+	require.Equal(t, confv1a1.Failure, acme.Results.Result)
+	require.Equal(t, confv1a1.Statistics{Failed: 1, Skipped: 1}, acme.Results.Statistics)
+	require.Empty(t, acme.Results.SupportedFeatures)
+	require.Equal(t, []string{string(supportAcmeFilter)}, acme.Results.UnsupportedFeatures)
+}
+
+func TestReportWithMetrics(t *testing.T) {
+	suite := &ConformanceTestSuite{results: map[string]testResult{}}
+	suite.results["Core"] = testResult{test: ConformanceTest{ShortName: "Core"}, result: resultSuccess}
+	suite.results["Status"] = testResult{test: ConformanceTest{ShortName: "Status"}, result: resultSuccess}
+	suite.recordConvergence(ConformanceTest{ShortName: "Core"}, []config.ConvergenceMetrics{
+		{TimeToFirstSuccess: time.Second, TimeToConsistency: 3 * time.Second},
+		{TimeToFirstSuccess: 2 * time.Second, TimeToConsistency: 2 * time.Second},
+	})
+	for i := 1; i <= 10; i++ {
+		suite.routeProgrammingLatencies = append(suite.routeProgrammingLatencies, time.Duration(i)*100*time.Millisecond)
+	}
+
+	report, err := suite.Report(confv1a1.Implementation{})
+	require.NoError(t, err)
+
+	require.Equal(t, []confv1a1.TestMetrics{{
+		Name:               "Core",
+		TimeToFirstSuccess: metav1.Duration{Duration: 2 * time.Second},
+		TimeToConsistency:  metav1.Duration{Duration: 3 * time.Second},
+	}}, report.Metrics, "only tests which awaited convergence must have metrics")
+	require.Equal(t, &confv1a1.LatencyMetrics{
+		Samples: 10,
+		P50:     metav1.Duration{Duration: 500 * time.Millisecond},
+		P90:     metav1.Duration{Duration: 900 * time.Millisecond},
+		Max:     metav1.Duration{Duration: time.Second},
+	}, report.RouteProgrammingLatency)
+}
+
+func TestReportNonConformant(t *testing.T) {
+	suite := &ConformanceTestSuite{
+		NonConformantReasons: []string{"MaxTimeToConsistency is 45s, above the max value of 30s for conformant implementations"},
+		results:              map[string]testResult{},
+	}
+	suite.results["Core"] = testResult{test: ConformanceTest{ShortName: "Core"}, result: resultSuccess}
+
+	report, err := suite.Report(confv1a1.Implementation{})
+	require.NoError(t, err)
+	require.Equal(t, suite.NonConformantReasons, report.NonConformantReasons)
+	require.Len(t, report.Profiles, 1)
+	require.Equal(t, confv1a1.Partial, report.Profiles[0].Core.Result, "non-conformant runs must not be a core success")
+}
+
+func TestReportEffectiveFeatures(t *testing.T) {
+	suite := New(Options{
+		SupportedFeatures: sets.New(SupportHTTPRouteMethodMatching),
+		ExemptFeatures:    sets.New(SupportReferenceGrant),
+		SkipTests:         []string{"Method", "Core"},
+	})
+	suite.results["Core"] = testResult{test: ConformanceTest{ShortName: "Core"}, result: resultSkippedExplicitly}
+
+	report, err := suite.Report(confv1a1.Implementation{})
+	require.NoError(t, err)
+	require.Equal(t, []string{string(SupportGateway), string(SupportHTTPRoute), string(SupportHTTPRouteMethodMatching)}, report.SupportedFeatures)
+	require.Equal(t, []string{string(SupportReferenceGrant)}, report.ExemptFeatures)
+	require.Equal(t, []string{"Core", "Method"}, report.SkippedTests)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// resultType is the outcome of a single conformance test.
+type resultType string
+
+const (
+	// resultSuccess indicates that the test ran and passed.
+	resultSuccess resultType = "success"
+
+	// resultFailure indicates that the test ran and failed.
+	resultFailure resultType = "failure"
+
+	// resultSkippedUnsupported indicates that the test was not run because
+	// the suite does not support one or more of the features it exercises.
+	resultSkippedUnsupported resultType = "skipped-unsupported"
+
+	// resultSkippedExplicitly indicates that the test was not run because it
+	// was listed in the SkipTests option of the suite.
+	resultSkippedExplicitly resultType = "skipped-explicitly"
+)
+
+// testResult records the outcome of a single conformance test along with the
+// test definition, so that results can later be grouped for reporting.
+type testResult struct {
+	test   ConformanceTest
+	result resultType
+
+	// timeToFirstSuccess and timeToConsistency are the longest times the
+	// test waited for a first successful response, and for consistent
+	// responses, out of the convergences it awaited.
+	timeToFirstSuccess time.Duration
+	timeToConsistency  time.Duration
+	convergences       int
+}
+
+// recordResult stores the outcome of the given test based on the state of
+// the provided testing.T. It must be called once the test and all of its
+// subtests have completed, which is achieved by registering it with t.Cleanup.
+func (suite *ConformanceTestSuite) recordResult(t *testing.T, test ConformanceTest) {
+	var result resultType
+	switch {
+	case suite.SkipTests.Has(test.ShortName):
+		result = resultSkippedExplicitly
+	case t.Failed():
+		result = resultFailure
+	case t.Skipped():
+		result = resultSkippedUnsupported
+	default:
+		result = resultSuccess
+	}
+
+	suite.resultsLock.Lock()
+	defer suite.resultsLock.Unlock()
+	res := suite.results[test.ShortName]
+	res.test, res.result = test, result
+	suite.results[test.ShortName] = res
+}
+
+// recordConvergence adds the metrics of the convergences awaited by the given
+// test to its result.
+func (suite *ConformanceTestSuite) recordConvergence(test ConformanceTest, metrics []config.ConvergenceMetrics) {
+	suite.resultsLock.Lock()
+	defer suite.resultsLock.Unlock()
+
+	res, ok := suite.results[test.ShortName]
+	if !ok {
+		return
+	}
+	for _, m := range metrics {
+		res.convergences++
+		if m.TimeToFirstSuccess > res.timeToFirstSuccess {
+			res.timeToFirstSuccess = m.TimeToFirstSuccess
+		}
+		if m.TimeToConsistency > res.timeToConsistency {
+			res.timeToConsistency = m.TimeToConsistency
+		}
+	}
+	suite.results[test.ShortName] = res
+}
@@ -17,22 +17,65 @@ limitations under the License.
 package suite
 
 import (
+	"context"
+	"fmt"
+	"io/fs"
+	"sync"
 	"testing"
+	"time"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
 	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
 	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
 )
 
+const (
+	// gatewayCRDName is the name of the Gateway CRD, which is used to
+	// discover the version of Gateway API installed in the cluster.
+	gatewayCRDName = "gateways.gateway.networking.k8s.io"
+
+	// bundleVersionAnnotation is set on all Gateway API CRDs and contains
+	// the release version of the bundle they were installed from.
+	bundleVersionAnnotation = "gateway.networking.k8s.io/bundle-version"
+
+	// grpcBaseManifests are the backends of the GRPCRoute tests, which are
+	// only applied along with the base manifests when GRPCRoute is
+	// supported.
+	grpcBaseManifests = "base/grpc-manifests.yaml"
+
+	// mirrorBaseManifests are the backends of the RequestMirror tests, which
+	// are only applied along with the base manifests when
+	// HTTPRouteRequestMirror is supported.
+	mirrorBaseManifests = "base/mirror-manifests.yaml"
+
+	// tcpBaseManifests and udpBaseManifests are the backends of the TCPRoute
+	// and UDPRoute tests, which are only applied along with the base
+	// manifests when the respective route kind is supported.
+	tcpBaseManifests = "base/tcp-manifests.yaml"
+	udpBaseManifests = "base/udp-manifests.yaml"
+)
+
 // SupportedFeature allows opting in to additional conformance tests at an
 // individual feature granularity.
 type SupportedFeature string
 
 const (
+	// This option indicates support for Gateway (core conformance).
+	SupportGateway SupportedFeature = "Gateway"
+
+	// This option indicates support for HTTPRoute (core conformance).
+	SupportHTTPRoute SupportedFeature = "HTTPRoute"
+
 	// This option indicates support for ReferenceGrant (core conformance).
 	// Opting out of this requires an implementation to have clearly implemented
 	// and documented equivalent safeguards.
@@ -41,6 +84,26 @@ const (
 	// This option indicates support for TLSRoute (extended conformance).
 	SupportTLSRoute SupportedFeature = "TLSRoute"
 
+	// This option indicates support for TCPRoute (experimental conformance).
+	SupportTCPRoute SupportedFeature = "TCPRoute"
+
+	// This option indicates support for UDPRoute (experimental conformance).
+	SupportUDPRoute SupportedFeature = "UDPRoute"
+
+	// This option indicates support for GRPCRoute (experimental conformance).
+	SupportGRPCRoute SupportedFeature = "GRPCRoute"
+
+	// This option indicates support for GRPCRoute method matching with
+	// regular expressions (implementation-specific conformance). The tests
+	// only use the RE2 syntax that is common to most regular expression
+	// engines.
+	SupportGRPCRouteRegexMatching SupportedFeature = "GRPCRouteRegexMatching"
+
+	// This option indicates support for routes attached to Services for
+	// east/west traffic, as defined by the GAMMA initiative (experimental
+	// conformance).
+	SupportMesh SupportedFeature = "Mesh"
+
 	// This option indicates support for HTTPRoute query param matching (extended conformance).
 	SupportHTTPRouteQueryParamMatching SupportedFeature = "HTTPRouteQueryParamMatching"
 
@@ -70,11 +133,26 @@ const (
 
 	// This option indicates support for HTTPRoute path rewrite (experimental conformance)
 	SupportHTTPRoutePathRewrite SupportedFeature = "HTTPRoutePathRewrite"
+
+	// This option indicates support for requesting static addresses in the
+	// Gateway spec (extended conformance). The addresses to request are
+	// provided with the UsableNetworkAddresses and UnusableNetworkAddresses
+	// options.
+	SupportGatewayStaticAddresses SupportedFeature = "GatewayStaticAddresses"
+
+	// This option indicates support for WebSocket connections, upgraded from
+	// HTTP/1.1, through HTTPRoutes (extended conformance).
+	SupportHTTPRouteWebSocket SupportedFeature = "HTTPRouteWebSocket"
+
+	// This option indicates support for HTTPRoute request mirror (extended conformance).
+	SupportHTTPRouteRequestMirror SupportedFeature = "HTTPRouteRequestMirror"
 )
 
 // StandardCoreFeatures are the features that are required to be conformant with
 // the Core API features that are part of the Standard release channel.
 var StandardCoreFeatures = sets.New(
+	SupportGateway,
+	SupportHTTPRoute,
 	SupportReferenceGrant,
 )
 
@@ -84,25 +162,46 @@ var StandardCoreFeatures = sets.New(
 // Note that the AllFeatures must in sync with defined features when the
 // feature constants change.
 var AllFeatures = sets.New(
+	SupportGateway,
+	SupportHTTPRoute,
 	SupportReferenceGrant,
 	SupportTLSRoute,
+	SupportTCPRoute,
+	SupportUDPRoute,
+	SupportGRPCRoute,
+	SupportGRPCRouteRegexMatching,
+	SupportMesh,
 	SupportHTTPRouteQueryParamMatching,
 	SupportHTTPRouteMethodMatching,
 	SupportHTTPResponseHeaderModification,
 	SupportRouteDestinationPortMatching,
 	SupportGatewayClassObservedGenerationBump,
+	SupportGatewayStaticAddresses,
 	SupportHTTPRoutePortRedirect,
 	SupportHTTPRouteSchemeRedirect,
 	SupportHTTPRoutePathRedirect,
 	SupportHTTPRouteHostRewrite,
 	SupportHTTPRoutePathRewrite,
+	SupportHTTPRouteRequestMirror,
+	SupportHTTPRouteWebSocket,
+)
+
+// unpublishedBackendFeatures are the features whose tests rely on backends
+// running the echo-basic image, which hack/build-and-push.sh only publishes
+// once conformance/echo-basic has been merged. These tests are skipped, and
+// their backends are not applied, until their manifests pin a published tag.
+var unpublishedBackendFeatures = sets.New(
+	SupportGRPCRoute,
+	SupportHTTPRouteWebSocket,
 )
 
 // ConformanceTestSuite defines the test suite used to run Gateway API
 // conformance tests.
 type ConformanceTestSuite struct {
 	Client            client.Client
+	Clientset         clientset.Interface
 	RoundTripper      roundtripper.RoundTripper
+	GRPCRoundTripper  grpc.RoundTripper
 	GatewayClassName  string
 	ControllerName    string
 	Debug             bool
@@ -112,21 +211,107 @@ type ConformanceTestSuite struct {
 	SupportedFeatures sets.Set[SupportedFeature]
 	TimeoutConfig     config.TimeoutConfig
 	SkipTests         sets.Set[string]
+
+	// ExemptFeatures are the features the implementation opted out of. They
+	// are never part of the SupportedFeatures, even when they are core
+	// features.
+	ExemptFeatures sets.Set[SupportedFeature]
+
+	// Parallelism is the maximum number of tests marked as Parallel that are
+	// run at the same time. Values lower than 2 run all tests sequentially.
+	Parallelism int
+
+	// RunTest is the ShortName of the only test to run. When empty, all tests
+	// are run.
+	RunTest string
+
+	// ConformanceProfiles are the names of the conformance profiles the suite
+	// is run for. When set, tests that are not part of any of these profiles
+	// are skipped.
+	ConformanceProfiles sets.Set[ConformanceProfileName]
+
+	// Extensions are the implementation-specific tests run by the suite in
+	// addition to the tests passed to Run.
+	Extensions []Extension
+
+	// UsableNetworkAddresses are addresses that the implementation can assign
+	// to a Gateway when requested in its spec.
+	UsableNetworkAddresses []v1beta1.GatewayAddress
+
+	// UnusableNetworkAddresses are addresses of a type supported by the
+	// implementation that can't be assigned to a Gateway, e.g. because they
+	// are outside of the pools the implementation allocates addresses from.
+	UnusableNetworkAddresses []v1beta1.GatewayAddress
+
+	// GatewayAPIVersion is the bundle version of the Gateway API CRDs
+	// installed in the cluster. It is discovered during Setup and left empty
+	// if it cannot be determined.
+	GatewayAPIVersion string
+
+	// NonConformantReasons explains why the run can't be used to claim
+	// conformance, e.g. because its TimeoutConfig exceeds the limits for
+	// conformant implementations. It is empty for runs that can be.
+	NonConformantReasons []string
+
+	// Mesh indicates that the suite is run in mesh mode, where routes are
+	// attached to Services instead of Gateways. Only the tests exercising
+	// the Mesh feature are run, requests are made from a client Pod, and no
+	// GatewayClass is required.
+	Mesh bool
+
+	// ArtifactsDir is the directory where the Gateway API objects and Events
+	// of the conformance namespaces, and the last round trips made, are
+	// written when a test fails, in a directory named after the test. No
+	// artifacts are written when empty.
+	ArtifactsDir string
+
+	// roundTripHistory records the round trips made by the default
+	// RoundTripper when ArtifactsDir is set.
+	roundTripHistory *roundtripper.History
+
+	// artifactCollectors are the artifact collectors of the running tests,
+	// keyed by the name of their testing.T.
+	artifactCollectors     map[string]*artifactCollector
+	artifactCollectorsLock sync.Mutex
+
+	// results holds the outcome of each test run by the suite, keyed by the
+	// test ShortName.
+	results     map[string]testResult
+	resultsLock sync.Mutex
+
+	// routeProgrammingLatencies are the latencies measured by
+	// MeasureRouteProgrammingLatency.
+	routeProgrammingLatencies []time.Duration
 }
 
 // Options can be used to initialize a ConformanceTestSuite.
 type Options struct {
-	Client           client.Client
+	Client client.Client
+	// Clientset is used for the operations controller-runtime clients do
+	// not support, such as reading Pod logs.
+	Clientset clientset.Interface
+	// RestConfig is used to exec in the client Pod requests are made from
+	// in mesh mode.
+	RestConfig       *rest.Config
 	GatewayClassName string
 	Debug            bool
 	RoundTripper     roundtripper.RoundTripper
+	GRPCRoundTripper grpc.RoundTripper
 	BaseManifests    string
 	NamespaceLabels  map[string]string
 	// ValidUniqueListenerPorts maps each listener port of each Gateway in the
-	// manifests to a valid, unique port. There must be as many
-	// ValidUniqueListenerPorts as there are listeners in the set of manifests.
-	// For example, given two Gateways, each with 2 listeners, there should be
-	// four ValidUniqueListenerPorts.
+	// manifests to a valid, unique port. Listeners of the same Gateway that
+	// share a port keep sharing the port they are mapped to. There must be as
+	// many ValidUniqueListenerPorts as there are distinct listener ports per
+	// Gateway in the set of manifests. For example, given two Gateways, each
+	// with 2 listeners on different ports, there should be four
+	// ValidUniqueListenerPorts.
+	// When Parallelism is greater than one, ports are instead kept unique
+	// across manifests that exist at the same time: the ports of the base
+	// manifests are held for the whole run, and those of a test manifest
+	// until the test completes. There must then be as many ports as there are
+	// listener ports in the base manifests, plus the listener ports of the
+	// manifests of the Parallelism tests with the most listener ports.
 	// If empty or nil, ports are not modified.
 	ValidUniqueListenerPorts []v1beta1.PortNumber
 
@@ -135,34 +320,147 @@ type Options struct {
 	CleanupBaseResources       bool
 	SupportedFeatures          sets.Set[SupportedFeature]
 	EnableAllSupportedFeatures bool
-	TimeoutConfig              config.TimeoutConfig
+	// ExemptFeatures are removed from the SupportedFeatures, once the core
+	// features of the standard channel and of the ConformanceProfiles have
+	// been added to them.
+	ExemptFeatures sets.Set[SupportedFeature]
+	TimeoutConfig  config.TimeoutConfig
 	// SkipTests contains all the tests not to be run and can be used to opt out
 	// of specific tests
 	SkipTests []string
+
+	// Parallelism is the maximum number of tests marked as Parallel that are
+	// run at the same time. Values lower than 2 run all tests sequentially.
+	Parallelism int
+
+	// RunTest is the ShortName of the only test to run. When empty, all tests
+	// are run.
+	RunTest string
+
+	// ConformanceProfiles selects the conformance profiles to run tests for.
+	// The core features of every selected profile are added to the
+	// SupportedFeatures.
+	ConformanceProfiles sets.Set[ConformanceProfileName]
+
+	// Extensions registers implementation-specific tests, along with their
+	// manifests, to be run in addition to the tests passed to Run.
+	Extensions []Extension
+
+	// UsableNetworkAddresses and UnusableNetworkAddresses are the addresses
+	// requested by the tests of the GatewayStaticAddresses feature, which
+	// respectively can and can't be assigned to a Gateway.
+	UsableNetworkAddresses   []v1beta1.GatewayAddress
+	UnusableNetworkAddresses []v1beta1.GatewayAddress
+
+	// ManifestFS replaces the embedded conformance manifests as the
+	// filesystem BaseManifests and test manifests are read from.
+	ManifestFS fs.FS
+
+	// ManifestValues are arbitrary values manifests can refer to as
+	// `{{ .Values.<key> }}` when rendered as templates.
+	ManifestValues map[string]string
+
+	// FailOnDrift fails tests when the spec of the resources they applied
+	// was changed by the implementation, instead of only logging the drift.
+	FailOnDrift bool
+
+	// ArtifactsDir is the directory where artifacts are written for failed
+	// tests. ArtifactsRoundTrips is the number of round trips made by the
+	// default RoundTripper that are kept for them, which defaults to
+	// DefaultArtifactsRoundTrips.
+	ArtifactsDir        string
+	ArtifactsRoundTrips int
+
+	// Mesh runs the suite in mesh mode. The mesh base manifests are applied
+	// instead of the Gateway ones, the core features of the Mesh conformance
+	// profile are supported instead of those of the standard channel, and
+	// the default RoundTripper makes requests from a client Pod.
+	Mesh bool
 }
 
 // New returns a new ConformanceTestSuite.
 func New(s Options) *ConformanceTestSuite {
 	config.SetupTimeoutConfig(&s.TimeoutConfig)
+	if s.TimeoutConfig.Convergences == nil {
+		s.TimeoutConfig.Convergences = &config.ConvergenceRecorder{}
+	}
+
+	var roundTripHistory *roundtripper.History
+	if s.ArtifactsDir != "" {
+		if s.ArtifactsRoundTrips == 0 {
+			s.ArtifactsRoundTrips = DefaultArtifactsRoundTrips
+		}
+		roundTripHistory = roundtripper.NewHistory(s.ArtifactsRoundTrips)
+	}
 
 	roundTripper := s.RoundTripper
-	if roundTripper == nil {
-		roundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	if roundTripper == nil && s.Mesh {
+		roundTripper = &roundtripper.ExecRoundTripper{Clientset: s.Clientset, RestConfig: s.RestConfig, Debug: s.Debug, TimeoutConfig: s.TimeoutConfig, History: roundTripHistory}
+	} else if roundTripper == nil {
+		roundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig, History: roundTripHistory}
+	}
+
+	grpcRoundTripper := s.GRPCRoundTripper
+	if grpcRoundTripper == nil {
+		grpcRoundTripper = &grpc.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	}
+
+	coreFeatures := StandardCoreFeatures
+	if s.Mesh {
+		coreFeatures = MeshConformanceProfile.CoreFeatures
 	}
 
 	if s.EnableAllSupportedFeatures == true {
-		s.SupportedFeatures = AllFeatures
+		s.SupportedFeatures = AllFeatures.Union(extensionFeatures(s.Extensions))
 	} else if s.SupportedFeatures == nil {
-		s.SupportedFeatures = StandardCoreFeatures
+		s.SupportedFeatures = coreFeatures
 	} else {
-		for feature := range StandardCoreFeatures {
+		for feature := range coreFeatures {
 			s.SupportedFeatures.Insert(feature)
 		}
 	}
 
+	if s.ConformanceProfiles == nil {
+		s.ConformanceProfiles = sets.New[ConformanceProfileName]()
+	}
+	if s.ConformanceProfiles.Len() > 0 {
+		// Copy the supported features so that the shared StandardCoreFeatures
+		// and AllFeatures sets are never modified.
+		s.SupportedFeatures = s.SupportedFeatures.Clone()
+		for name := range s.ConformanceProfiles {
+			if profile, err := getConformanceProfileForName(name); err == nil {
+				s.SupportedFeatures.Insert(profile.CoreFeatures.UnsortedList()...)
+			}
+		}
+	}
+
+	// Exempt features are removed last, so that they are not added back as
+	// core features. Difference returns a new set, which leaves the shared
+	// feature sets unmodified.
+	if s.ExemptFeatures == nil {
+		s.ExemptFeatures = sets.New[SupportedFeature]()
+	}
+	s.SupportedFeatures = s.SupportedFeatures.Difference(s.ExemptFeatures)
+
+	var manifestFS []fs.FS
+	for _, extension := range s.Extensions {
+		if extension.Manifests != nil {
+			manifestFS = append(manifestFS, extension.Manifests)
+		}
+	}
+
+	// Ports are only pooled when tests run in parallel, so that sequential runs
+	// keep assigning them in order for every manifest.
+	var listenerPorts *kubernetes.ListenerPortPool
+	if len(s.ValidUniqueListenerPorts) > 0 && s.Parallelism > 1 {
+		listenerPorts = kubernetes.NewListenerPortPool(s.ValidUniqueListenerPorts)
+	}
+
 	suite := &ConformanceTestSuite{
 		Client:           s.Client,
+		Clientset:        s.Clientset,
 		RoundTripper:     roundTripper,
+		GRPCRoundTripper: grpcRoundTripper,
 		GatewayClassName: s.GatewayClassName,
 		Debug:            s.Debug,
 		Cleanup:          s.CleanupBaseResources,
@@ -170,14 +468,49 @@ func New(s Options) *ConformanceTestSuite {
 		Applier: kubernetes.Applier{
 			NamespaceLabels:          s.NamespaceLabels,
 			ValidUniqueListenerPorts: s.ValidUniqueListenerPorts,
+			ListenerPorts:            listenerPorts,
+			FS:                       s.ManifestFS,
+			FailOnDrift:              s.FailOnDrift,
+			ManifestFS:               manifestFS,
+			TemplateValues: &kubernetes.ManifestTemplateValues{
+				GatewayClassName: s.GatewayClassName,
+				Namespaces: kubernetes.ManifestNamespaces{
+					Infra:      "gateway-conformance-infra",
+					AppBackend: "gateway-conformance-app-backend",
+					WebBackend: "gateway-conformance-web-backend",
+					Mesh:       MeshNamespace,
+				},
+				ListenerPorts:      s.ValidUniqueListenerPorts,
+				CertificateSecrets: certificateSecretNames(),
+				Values:             s.ManifestValues,
+			},
 		},
-		SupportedFeatures: s.SupportedFeatures,
-		TimeoutConfig:     s.TimeoutConfig,
-		SkipTests:         sets.New(s.SkipTests...),
+		SupportedFeatures:   s.SupportedFeatures,
+		TimeoutConfig:       s.TimeoutConfig,
+		SkipTests:           sets.New(s.SkipTests...),
+		ExemptFeatures:      s.ExemptFeatures,
+		Parallelism:         s.Parallelism,
+		RunTest:             s.RunTest,
+		ConformanceProfiles: s.ConformanceProfiles,
+		Extensions:          s.Extensions,
+
+		UsableNetworkAddresses:   s.UsableNetworkAddresses,
+		UnusableNetworkAddresses: s.UnusableNetworkAddresses,
+		NonConformantReasons:     config.ConformanceLimitViolations(s.TimeoutConfig),
+		Mesh:                     s.Mesh,
+		ArtifactsDir:             s.ArtifactsDir,
+		roundTripHistory:         roundTripHistory,
+		artifactCollectors:       map[string]*artifactCollector{},
+		results:                  map[string]testResult{},
+	}
+	if suite.ArtifactsDir != "" {
+		suite.Applier.BeforeDelete = suite.collectArtifactsBeforeDelete
 	}
 
 	// apply defaults
-	if suite.BaseManifests == "" {
+	if suite.BaseManifests == "" && suite.Mesh {
+		suite.BaseManifests = meshBaseManifests
+	} else if suite.BaseManifests == "" {
 		suite.BaseManifests = "base/manifests.yaml"
 	}
 
@@ -187,22 +520,79 @@ func New(s Options) *ConformanceTestSuite {
 // Setup ensures the base resources required for conformance tests are installed
 // in the cluster. It also ensures that all relevant resources are ready.
 func (suite *ConformanceTestSuite) Setup(t *testing.T) {
-	t.Logf("Test Setup: Ensuring GatewayClass has been accepted")
-	suite.ControllerName = kubernetes.GWCMustHaveAcceptedConditionTrue(t, suite.Client, suite.TimeoutConfig, suite.GatewayClassName)
+	for name := range suite.ConformanceProfiles {
+		if _, err := getConformanceProfileForName(name); err != nil {
+			t.Fatalf("Test Setup: %v", err)
+		}
+	}
+
+	t.Logf("Test Setup: Supported features: %v", sortedFeatures(suite.SupportedFeatures))
+	t.Logf("Test Setup: Exempt features: %v", sortedFeatures(suite.ExemptFeatures))
+	t.Logf("Test Setup: Skipped tests: %v", sets.List(suite.SkipTests))
+	for _, reason := range suite.NonConformantReasons {
+		t.Logf("Test Setup: Results can't be used to claim conformance: %s", reason)
+	}
+
+	if suite.Mesh {
+		t.Logf("Test Setup: Running in mesh mode, routes are attached to Services")
+	} else {
+		t.Logf("Test Setup: Ensuring GatewayClass has been accepted")
+		suite.ControllerName = kubernetes.GWCMustHaveAcceptedConditionTrue(t, suite.Client, suite.TimeoutConfig, suite.GatewayClassName)
+	}
+
+	version, err := gatewayAPIVersion(suite.Client, suite.TimeoutConfig)
+	if err != nil {
+		t.Logf("Test Setup: Unable to determine Gateway API version: %v", err)
+	}
+	suite.GatewayAPIVersion = version
 
 	suite.Applier.GatewayClass = suite.GatewayClassName
 	suite.Applier.ControllerName = suite.ControllerName
+	if suite.Applier.TemplateValues != nil {
+		suite.Applier.TemplateValues.GatewayClassName = suite.GatewayClassName
+		suite.Applier.TemplateValues.ControllerName = suite.ControllerName
+	}
 
 	t.Logf("Test Setup: Applying base manifests")
 	suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.BaseManifests, suite.Cleanup)
+	if !suite.Mesh && suite.SupportedFeatures.Has(SupportGRPCRoute) && !unpublishedBackendFeatures.Has(SupportGRPCRoute) {
+		t.Logf("Test Setup: Applying GRPCRoute backend manifests")
+		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, grpcBaseManifests, suite.Cleanup)
+	}
+	if !suite.Mesh && suite.SupportedFeatures.Has(SupportHTTPRouteRequestMirror) {
+		t.Logf("Test Setup: Applying RequestMirror backend manifests")
+		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, mirrorBaseManifests, suite.Cleanup)
+	}
+	if !suite.Mesh && suite.SupportedFeatures.Has(SupportTCPRoute) {
+		t.Logf("Test Setup: Applying TCPRoute backend manifests")
+		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, tcpBaseManifests, suite.Cleanup)
+	}
+	if !suite.Mesh && suite.SupportedFeatures.Has(SupportUDPRoute) {
+		t.Logf("Test Setup: Applying UDPRoute backend manifests")
+		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, udpBaseManifests, suite.Cleanup)
+	}
+
+	// The certificates are only used by Gateway listeners, and their
+	// namespaces are not created in mesh mode.
+	if !suite.Mesh {
+		t.Logf("Test Setup: Applying programmatic resources")
+		for _, cert := range certificateSecrets {
+			secret := kubernetes.MustCreateSelfSignedCertSecret(t, cert.namespace, cert.name, cert.hosts)
+			suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
+		}
+	}
+
+	for _, extension := range suite.Extensions {
+		for _, manifest := range extension.BaseManifests {
+			t.Logf("Test Setup: Applying %s base manifests from %s", extension.Name, manifest)
+			suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, manifest, suite.Cleanup)
+		}
+	}
 
-	t.Logf("Test Setup: Applying programmatic resources")
-	secret := kubernetes.MustCreateSelfSignedCertSecret(t, "gateway-conformance-web-backend", "certificate", []string{"*"})
-	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-	secret = kubernetes.MustCreateSelfSignedCertSecret(t, "gateway-conformance-infra", "tls-validity-checks-certificate", []string{"*"})
-	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-	secret = kubernetes.MustCreateSelfSignedCertSecret(t, "gateway-conformance-infra", "tls-passthrough-checks-certificate", []string{"abc.example.com"})
-	suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
+	if suite.Mesh {
+		suite.setupMesh(t)
+		return
+	}
 
 	t.Logf("Test Setup: Ensuring Gateways and Pods from base manifests are ready")
 	namespaces := []string{
@@ -213,13 +603,125 @@ func (suite *ConformanceTestSuite) Setup(t *testing.T) {
 	kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, namespaces)
 }
 
-// Run runs the provided set of conformance tests.
+// certificateSecrets are the self-signed certificate Secrets created during
+// Setup, along with the hostnames they are valid for. The certificates used by
+// the HTTPS listener tests are only valid for the hostname of the listener
+// they are used by, so that tests can tell which certificate was served.
+var certificateSecrets = []struct {
+	namespace string
+	name      string
+	hosts     []string
+}{
+	{"gateway-conformance-web-backend", "certificate", []string{"*"}},
+	{"gateway-conformance-infra", "tls-validity-checks-certificate", []string{"*"}},
+	{"gateway-conformance-infra", "tls-passthrough-checks-certificate", []string{"abc.example.com"}},
+	{"gateway-conformance-web-backend", "tls-reference-grant-certificate", []string{"reference-grant.example.com"}},
+	{"gateway-conformance-infra", "tls-first-certificate", []string{"first.example.com"}},
+	{"gateway-conformance-infra", "tls-second-certificate", []string{"second.example.org"}},
+	{"gateway-conformance-infra", "tls-exact-certificate", []string{"exact.wildcard.example.com"}},
+	{"gateway-conformance-infra", "tls-wildcard-certificate", []string{"*.wildcard.example.com"}},
+	{"gateway-conformance-infra", "tls-secure-certificate", []string{"secure.example.com"}},
+}
+
+// certificateSecretNames maps the names of the certificateSecrets to their
+// namespaced names.
+func certificateSecretNames() map[string]types.NamespacedName {
+	names := make(map[string]types.NamespacedName, len(certificateSecrets))
+	for _, cert := range certificateSecrets {
+		names[cert.name] = types.NamespacedName{Namespace: cert.namespace, Name: cert.name}
+	}
+	return names
+}
+
+// gatewayAPIVersion returns the bundle version annotation of the Gateway CRD
+// installed in the cluster.
+func gatewayAPIVersion(c client.Client, timeoutConfig config.TimeoutConfig) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeoutConfig.GetTimeout)
+	defer cancel()
+
+	crd := &metav1.PartialObjectMetadata{}
+	crd.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition"))
+	if err := c.Get(ctx, types.NamespacedName{Name: gatewayCRDName}, crd); err != nil {
+		return "", fmt.Errorf("error fetching %s CRD: %w", gatewayCRDName, err)
+	}
+
+	version, ok := crd.Annotations[bundleVersionAnnotation]
+	if !ok {
+		return "", fmt.Errorf("%s CRD does not have the %s annotation", gatewayCRDName, bundleVersionAnnotation)
+	}
+	return version, nil
+}
+
+// Run runs the provided set of conformance tests and records the result of
+// each of them so that they can be included in a conformance report. When the
+// suite Parallelism is greater than one, tests marked as Parallel are run
+// concurrently once all other tests have completed. If RunTest is set, only
+// the test with that ShortName is run. The tests of the Extensions of the
+// suite are run after the provided tests.
 func (suite *ConformanceTestSuite) Run(t *testing.T, tests []ConformanceTest) {
+	tests = suite.withExtensionTests(t, tests)
+	if suite.RunTest != "" {
+		tests = filterTests(t, tests, suite.RunTest)
+	}
+
+	var parallelTests []ConformanceTest
+	for _, test := range tests {
+		if suite.Parallelism > 1 && test.Parallel {
+			parallelTests = append(parallelTests, test)
+			continue
+		}
+		suite.runTest(t, test)
+	}
+
+	if len(parallelTests) == 0 {
+		return
+	}
+
+	t.Logf("Running %d tests in parallel with a parallelism of %d", len(parallelTests), suite.Parallelism)
+	sem := make(chan struct{}, suite.Parallelism)
+	var wg sync.WaitGroup
+	for _, test := range parallelTests {
+		test := test
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			suite.runTest(t, test)
+		}()
+	}
+	wg.Wait()
+}
+
+// filterTests returns the test with the given ShortName, failing if there is
+// no such test.
+func filterTests(t *testing.T, tests []ConformanceTest, shortName string) []ConformanceTest {
+	names := make([]string, 0, len(tests))
 	for _, test := range tests {
-		t.Run(test.ShortName, func(t *testing.T) {
-			test.Run(t, suite)
-		})
+		if test.ShortName == shortName {
+			return []ConformanceTest{test}
+		}
+		names = append(names, test.ShortName)
 	}
+	t.Fatalf("%s is not a valid test name, valid tests are: %v", shortName, names)
+	return nil
+}
+
+// runTest runs a single conformance test as a subtest of t and records its
+// result.
+func (suite *ConformanceTestSuite) runTest(t *testing.T, test ConformanceTest) {
+	var name string
+	t.Run(test.ShortName, func(t *testing.T) {
+		name = t.Name()
+		// The result is recorded in a cleanup function, so that the failures
+		// of parallel subtests, which only run once the test function has
+		// returned, are taken into account.
+		t.Cleanup(func() { suite.recordResult(t, test) })
+		test.Run(t, suite)
+	})
+	// The convergence metrics are taken once t.Run has returned, which is
+	// after all parallel subtests of the test have completed.
+	suite.recordConvergence(test, suite.TimeoutConfig.Convergences.Take(name))
 }
 
 // ConformanceTest is used to define each individual conformance test.
@@ -229,15 +731,31 @@ type ConformanceTest struct {
 	Features    []SupportedFeature
 	Manifests   []string
 	Slow        bool
-	Parallel    bool
-	Test        func(*testing.T, *ConformanceTestSuite)
+	// Parallel indicates that the test can be run at the same time as other
+	// Parallel tests. Such tests must only depend on resources they create
+	// themselves, e.g. their own Gateways, and must not change or wait for
+	// the readiness of resources that are shared with other tests.
+	Parallel bool
+	Test     func(*testing.T, *ConformanceTestSuite)
 }
 
 // Run runs an individual tests, applying and cleaning up the required manifests
 // before calling the Test function.
 func (test *ConformanceTest) Run(t *testing.T, suite *ConformanceTestSuite) {
-	if test.Parallel {
-		t.Parallel()
+	// Check that the test is part of at least one of the conformance profiles
+	// the suite is run for, if any were selected. Tests of extensions are not
+	// part of any profile and are only gated by their features.
+	_, isExtensionTest := suite.extensionFor(test.ShortName)
+	if suite.ConformanceProfiles.Len() > 0 && !isExtensionTest && !suite.inConformanceProfiles(test) {
+		t.Skipf("Skipping %s: test is not part of any of the selected conformance profiles %v", test.ShortName, sets.List(suite.ConformanceProfiles))
+	}
+
+	// Tests of routes attached to Services only run in mesh mode, and the
+	// Gateways all other tests rely on only exist outside of it.
+	if isMeshTest := sets.New(test.Features...).Has(SupportMesh); isMeshTest && !suite.Mesh {
+		t.Skipf("Skipping %s: test requires the suite to be run in mesh mode", test.ShortName)
+	} else if !isMeshTest && suite.Mesh {
+		t.Skipf("Skipping %s: test requires Gateways, which are not available in mesh mode", test.ShortName)
 	}
 
 	// Check that all features exercised by the test have been opted into by
@@ -248,12 +766,25 @@ func (test *ConformanceTest) Run(t *testing.T, suite *ConformanceTestSuite) {
 		}
 	}
 
+	for _, feature := range test.Features {
+		if unpublishedBackendFeatures.Has(feature) {
+			t.Skipf("Skipping %s: the backends of %s tests run an echo-basic image that has not been published yet", test.ShortName, feature)
+		}
+	}
+
 	// check that the test should not be skipped
 	if suite.SkipTests.Has(test.ShortName) {
 		t.Logf("Skipping %s", test.ShortName)
 		return
 	}
 
+	// The artifacts of failed tests are collected before the first of the
+	// resources applied by the test, or by its subtests, is deleted, or once
+	// the test and its subtests complete if none is.
+	if suite.ArtifactsDir != "" {
+		suite.registerArtifactCollector(t, test)
+	}
+
 	for _, manifestLocation := range test.Manifests {
 		t.Logf("Applying %s", manifestLocation)
 		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, manifestLocation, true)
@@ -261,3 +792,15 @@ func (test *ConformanceTest) Run(t *testing.T, suite *ConformanceTestSuite) {
 
 	test.Test(t, suite)
 }
+
+// inConformanceProfiles returns true if the test is part of any of the
+// conformance profiles selected for the suite.
+func (suite *ConformanceTestSuite) inConformanceProfiles(test *ConformanceTest) bool {
+	for name := range suite.ConformanceProfiles {
+		profile, err := getConformanceProfileForName(name)
+		if err == nil && profile.HasTest(*test) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func TestRunParallel(t *testing.T) {
+	var (
+		lock        sync.Mutex
+		order       []string
+		running     int32
+		maxRunning  int32
+		parallelRan int32
+	)
+
+	record := func(name string) {
+		lock.Lock()
+		defer lock.Unlock()
+		order = append(order, name)
+	}
+	parallelTest := func(name string) ConformanceTest {
+		return ConformanceTest{
+			ShortName: name,
+			Parallel:  true,
+			Test: func(t *testing.T, _ *ConformanceTestSuite) {
+				current := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					max := atomic.LoadInt32(&maxRunning)
+					if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+						break
+					}
+				}
+				time.Sleep(100 * time.Millisecond)
+				atomic.AddInt32(&parallelRan, 1)
+				record(name)
+			},
+		}
+	}
+	sequentialTest := func(name string) ConformanceTest {
+		return ConformanceTest{
+			ShortName: name,
+			Test: func(t *testing.T, _ *ConformanceTestSuite) {
+				require.Zero(t, atomic.LoadInt32(&parallelRan), "sequential tests must run before parallel tests")
+				record(name)
+			},
+		}
+	}
+
+	suite := New(Options{Parallelism: 2})
+	suite.Run(t, []ConformanceTest{
+		parallelTest("parallel-1"),
+		sequentialTest("sequential-1"),
+		parallelTest("parallel-2"),
+		parallelTest("parallel-3"),
+		sequentialTest("sequential-2"),
+	})
+
+	require.Equal(t, []string{"sequential-1", "sequential-2"}, order[:2])
+	require.ElementsMatch(t, []string{"parallel-1", "parallel-2", "parallel-3"}, order[2:])
+	require.Equal(t, int32(2), atomic.LoadInt32(&maxRunning))
+	require.Len(t, suite.results, 5)
+	for name, res := range suite.results {
+		require.Equal(t, resultSuccess, res.result, "unexpected result for %s", name)
+	}
+}
+
+func TestRunWithFailingParallelSubtest(t *testing.T) {
+	// The failing subtest would fail this test as well, so the suite is run in
+	// a separate process, which reports the recorded result on its output.
+	if os.Getenv("GATEWAY_CONFORMANCE_FAILING_SUBTEST") == "1" {
+		suite := New(Options{})
+		suite.Run(t, []ConformanceTest{{
+			ShortName: "failing-subtest",
+			Test: func(t *testing.T, _ *ConformanceTestSuite) {
+				t.Run("subtest", func(t *testing.T) {
+					t.Parallel()
+					t.Error("subtest failed")
+				})
+			},
+		}})
+		fmt.Printf("result=%s\n", suite.results["failing-subtest"].result)
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestRunWithFailingParallelSubtest$", "-test.v")
+	cmd.Env = append(os.Environ(), "GATEWAY_CONFORMANCE_FAILING_SUBTEST=1")
+	out, err := cmd.CombinedOutput()
+	require.Error(t, err, "the failing subtest must fail the run")
+	require.Contains(t, string(out), fmt.Sprintf("result=%s\n", resultFailure))
+}
+
+func TestRunUnpublishedBackendFeatures(t *testing.T) {
+	ran := false
+	suite := New(Options{SupportedFeatures: sets.New(SupportGateway, SupportGRPCRoute)})
+	suite.Run(t, []ConformanceTest{{
+		ShortName: "grpc",
+		Features:  []SupportedFeature{SupportGateway, SupportGRPCRoute},
+		Test: func(t *testing.T, _ *ConformanceTestSuite) {
+			ran = true
+		},
+	}})
+
+	require.False(t, ran, "tests of features with unpublished backends must be skipped")
+	require.Equal(t, resultSkippedUnsupported, suite.results["grpc"].result)
+}
+
+func TestRunSequentially(t *testing.T) {
+	var order []string
+	test := func(name string, parallel bool) ConformanceTest {
+		return ConformanceTest{
+			ShortName: name,
+			Parallel:  parallel,
+			Test: func(t *testing.T, _ *ConformanceTestSuite) {
+				order = append(order, name)
+			},
+		}
+	}
+
+	suite := New(Options{})
+	suite.Run(t, []ConformanceTest{
+		test("first", true),
+		test("second", false),
+		test("third", true),
+	})
+
+	require.Equal(t, []string{"first", "second", "third"}, order)
+}
+
+func TestRunSingleTest(t *testing.T) {
+	var ran []string
+	test := func(name string) ConformanceTest {
+		return ConformanceTest{
+			ShortName: name,
+			Test: func(t *testing.T, _ *ConformanceTestSuite) {
+				ran = append(ran, name)
+			},
+		}
+	}
+
+	suite := New(Options{RunTest: "second"})
+	suite.Run(t, []ConformanceTest{test("first"), test("second"), test("third")})
+
+	require.Equal(t, []string{"second"}, ran)
+	require.Len(t, suite.results, 1)
+}
+
+func TestNewListenerPortPool(t *testing.T) {
+	ports := []v1beta1.PortNumber{8080, 8081}
+
+	require.Nil(t, New(Options{ValidUniqueListenerPorts: ports}).Applier.ListenerPorts, "ports must be assigned in order when tests run sequentially")
+	require.Nil(t, New(Options{ValidUniqueListenerPorts: ports, Parallelism: 1}).Applier.ListenerPorts, "ports must be assigned in order when tests run sequentially")
+	require.NotNil(t, New(Options{ValidUniqueListenerPorts: ports, Parallelism: 2}).Applier.ListenerPorts, "ports must be pooled when tests run in parallel")
+}
@@ -35,14 +35,21 @@ func MakeTLSRequestAndExpectEventuallyConsistentResponse(t *testing.T, r roundtr
 
 	req := http.MakeRequest(t, &expected, gwAddr, "HTTPS", "https")
 
-	WaitForConsistentTLSResponse(t, r, req, expected, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig.MaxTimeToConsistency, cPem, keyPem, server)
+	waitForConsistentTLSResponse(t, r, req, expected, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig, cPem, keyPem, server)
 }
 
 // WaitForConsistentTLSResponse - repeats the provided request until it completes with a response having
 // the expected response consistently. The provided threshold determines how many times in
-// a row this must occur to be considered "consistent".
+// a row this must occur to be considered "consistent". Each failed attempt has a 1s delay.
 func WaitForConsistentTLSResponse(t *testing.T, r roundtripper.RoundTripper, req roundtripper.Request, expected http.ExpectedResponse, threshold int, maxTimeToConsistency time.Duration, cPem, keyPem []byte, server string) {
-	http.AwaitConvergence(t, threshold, maxTimeToConsistency, func(elapsed time.Duration) bool {
+	waitForConsistentTLSResponse(t, r, req, expected, threshold, config.TimeoutConfig{
+		MaxTimeToConsistency: maxTimeToConsistency,
+		ConvergenceBackoff:   config.ConstantBackoff(time.Second),
+	}, cPem, keyPem, server)
+}
+
+func waitForConsistentTLSResponse(t *testing.T, r roundtripper.RoundTripper, req roundtripper.Request, expected http.ExpectedResponse, threshold int, timeoutConfig config.TimeoutConfig, cPem, keyPem []byte, server string) {
+	http.AwaitConvergenceWithTimeoutConfig(t, threshold, timeoutConfig, func(elapsed time.Duration) bool {
 		req.KeyPem = keyPem
 		req.CertPem = cPem
 		req.Server = server
@@ -62,3 +69,34 @@ func WaitForConsistentTLSResponse(t *testing.T, r roundtripper.RoundTripper, req
 	})
 	t.Logf("Request passed")
 }
+
+// MakeTLSRequestAndExpectEventuallyConsistentFailure makes a request with the
+// given parameters until it consistently fails to get the expected response,
+// either because the request fails, e.g. the TLS handshake is rejected, or
+// because the response doesn't match expectations, e.g. a different
+// certificate is served or a different backend is reached.
+func MakeTLSRequestAndExpectEventuallyConsistentFailure(t *testing.T, r roundtripper.RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, cPem, keyPem []byte, server string, expected http.ExpectedResponse) {
+	t.Helper()
+
+	req := http.MakeRequest(t, &expected, gwAddr, "HTTPS", "https")
+	req.KeyPem = keyPem
+	req.CertPem = cPem
+	req.Server = server
+
+	http.AwaitConvergenceWithTimeoutConfig(t, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig, func(elapsed time.Duration) bool {
+		cReq, cRes, err := r.CaptureRoundTrip(req)
+		if err != nil {
+			t.Logf("Request failed as expected: %v (after %v)", err.Error(), elapsed)
+			return true
+		}
+
+		if err := http.CompareRequest(&req, cReq, cRes, expected); err != nil {
+			t.Logf("Response no longer matches as expected: %v (after %v)", err, elapsed)
+			return true
+		}
+
+		t.Logf("Request still succeeding, not failing yet (after %v)", elapsed)
+		return false
+	})
+	t.Logf("Request failed consistently")
+}
@@ -79,3 +79,16 @@ docker buildx build \
     --platform ${BUILDX_PLATFORMS} \
     --push \
     .
+
+echo "Building and pushing echo-basic image...${BUILDX_PLATFORMS}"
+
+# The echo-basic image is a backend of the conformance tests. Their manifests
+# pin it by the GIT_TAG of a pushed build, which has to be bumped there after
+# changes to conformance/echo-basic have been pushed.
+docker buildx build \
+    -t ${REGISTRY}/echo-basic:${GIT_TAG} \
+    -t ${REGISTRY}/echo-basic:${VERSION_TAG} \
+    --platform ${BUILDX_PLATFORMS} \
+    --push \
+    -f docker/Dockerfile.echo-basic \
+    .
@@ -0,0 +1,54 @@
+#!/usr/bin/env bash
+
+# Copyright 2023 The Kubernetes Authors.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# Generates the Go code of the gRPC echo service of the conformance backends,
+# conformance/echo-basic/grpcecho, from conformance/echo-basic/grpcecho.proto.
+# protoc must be installed, the Go plugins are installed by this script.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+readonly SCRIPT_ROOT="$(cd "$(dirname "${BASH_SOURCE}")"/.. && pwd)"
+readonly PROTOC_GEN_GO_VERSION="v1.28.1"
+readonly PROTOC_GEN_GO_GRPC_VERSION="v1.2.0"
+readonly PROTO_DIR="${SCRIPT_ROOT}/conformance/echo-basic"
+readonly OUTPUT_DIR="${PROTO_DIR}/grpcecho"
+
+if ! command -v protoc >/dev/null; then
+  echo "protoc is required to generate the gRPC echo service, see https://grpc.io/docs/protoc-installation/"
+  exit 1
+fi
+
+GOBIN="$(mktemp -d)"
+trap 'rm -rf "${GOBIN}"' EXIT
+export GOBIN
+go install "google.golang.org/protobuf/cmd/protoc-gen-go@${PROTOC_GEN_GO_VERSION}"
+go install "google.golang.org/grpc/cmd/protoc-gen-go-grpc@${PROTOC_GEN_GO_GRPC_VERSION}"
+
+protoc \
+  --plugin="protoc-gen-go=${GOBIN}/protoc-gen-go" \
+  --plugin="protoc-gen-go-grpc=${GOBIN}/protoc-gen-go-grpc" \
+  --proto_path="${PROTO_DIR}" \
+  --go_out="${OUTPUT_DIR}" --go_opt=paths=source_relative \
+  --go-grpc_out="${OUTPUT_DIR}" --go-grpc_opt=paths=source_relative \
+  "${PROTO_DIR}/grpcecho.proto"
+
+# Add the license header expected of generated files.
+for file in "${OUTPUT_DIR}"/*.pb.go; do
+  { cat "${SCRIPT_ROOT}/hack/boilerplate/boilerplate.generatego.txt"; echo; cat "${file}"; } > "${file}.tmp"
+  mv "${file}.tmp" "${file}"
+done
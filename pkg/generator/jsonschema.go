@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// writeJSONSchemas writes a standalone JSON Schema for every version of the
+// provided CRD. The file layout follows the
+// "{{.Group}}/{{.ResourceKind}}_{{.ResourceAPIVersion}}.json" convention that
+// kubeconform and yaml-language-server use to look up schemas for custom
+// resources.
+func writeJSONSchemas(channel string, crd *apiext.CustomResourceDefinition) error {
+	dir := filepath.Join("config", "jsonschema", channel, crd.Spec.Group)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for _, version := range crd.Spec.Versions {
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+
+		schema, err := jsonSchemaForVersion(crd.Spec.Group, crd.Spec.Names.Kind, version)
+		if err != nil {
+			return fmt.Errorf("failed to build JSON Schema for %s %s: %w", crd.Spec.Names.Kind, version.Name, err)
+		}
+
+		out, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON Schema for %s %s: %w", crd.Spec.Names.Kind, version.Name, err)
+		}
+		out = append(out, '\n')
+
+		fileName := filepath.Join(dir, fmt.Sprintf("%s_%s.json", strings.ToLower(crd.Spec.Names.Kind), version.Name))
+		if err := os.WriteFile(fileName, out, 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// jsonSchemaForVersion converts the OpenAPI v3 schema of a single CRD version
+// into a draft-07 JSON Schema, pinning apiVersion and kind to constants so
+// that editors and validators only match manifests of this exact kind and
+// version.
+func jsonSchemaForVersion(group, kind string, version apiext.CustomResourceDefinitionVersion) (map[string]interface{}, error) {
+	raw, err := json.Marshal(version.Schema.OpenAPIV3Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, err
+	}
+	openAPIToJSONSchema(schema)
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	if properties == nil {
+		properties = map[string]interface{}{}
+		schema["properties"] = properties
+	}
+	properties["apiVersion"] = map[string]interface{}{
+		"type":  "string",
+		"const": fmt.Sprintf("%s/%s", group, version.Name),
+	}
+	properties["kind"] = map[string]interface{}{
+		"type":  "string",
+		"const": kind,
+	}
+
+	required := []interface{}{"apiVersion", "kind"}
+	if existing, ok := schema["required"].([]interface{}); ok {
+		for _, field := range existing {
+			if field != "apiVersion" && field != "kind" {
+				required = append(required, field)
+			}
+		}
+	}
+	schema["required"] = required
+
+	schema["$schema"] = jsonSchemaDraft
+	schema["title"] = fmt.Sprintf("%s %s/%s", kind, group, version.Name)
+
+	return schema, nil
+}
+
+// openAPIToJSONSchema rewrites the OpenAPI specific keywords that have no
+// direct JSON Schema equivalent. It walks the schema recursively and modifies
+// it in place.
+func openAPIToJSONSchema(schema map[string]interface{}) {
+	if intOrString, _ := schema["x-kubernetes-int-or-string"].(bool); intOrString && schema["anyOf"] == nil {
+		delete(schema, "type")
+		schema["oneOf"] = []interface{}{
+			map[string]interface{}{"type": "string"},
+			map[string]interface{}{"type": "integer"},
+		}
+	}
+
+	if nullable, _ := schema["nullable"].(bool); nullable {
+		if t, ok := schema["type"].(string); ok {
+			schema["type"] = []interface{}{t, "null"}
+		}
+	}
+	delete(schema, "nullable")
+
+	for _, key := range []string{"properties", "patternProperties"} {
+		if props, ok := schema[key].(map[string]interface{}); ok {
+			for _, prop := range props {
+				if propSchema, ok := prop.(map[string]interface{}); ok {
+					openAPIToJSONSchema(propSchema)
+				}
+			}
+		}
+	}
+
+	for _, key := range []string{"items", "additionalProperties", "not"} {
+		if sub, ok := schema[key].(map[string]interface{}); ok {
+			openAPIToJSONSchema(sub)
+		}
+	}
+
+	for _, key := range []string{"allOf", "anyOf", "oneOf"} {
+		if subs, ok := schema[key].([]interface{}); ok {
+			for _, sub := range subs {
+				if subSchema, ok := sub.(map[string]interface{}); ok {
+					openAPIToJSONSchema(subSchema)
+				}
+			}
+		}
+	}
+}
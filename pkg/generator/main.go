@@ -129,6 +129,11 @@ func main() {
 			if err != nil {
 				log.Fatalf("failed to write CRD: %s", err)
 			}
+
+			err = writeJSONSchemas(channel, channelCrd)
+			if err != nil {
+				log.Fatalf("failed to write JSON Schema: %s", err)
+			}
 		}
 	}
 }